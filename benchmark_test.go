@@ -0,0 +1,87 @@
+package go_promise
+
+import (
+	"errors"
+	"testing"
+)
+
+func BenchmarkResolve(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = Await[int](Resolve(10))
+	}
+}
+
+func BenchmarkReject(b *testing.B) {
+	expected := errors.New("error")
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = Reject(expected).Await()
+	}
+}
+
+func BenchmarkFunction(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = Await[int](Function(func() (int, error) {
+			return 10, nil
+		}))
+	}
+}
+
+func BenchmarkNew(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = Await[int](New(func(resolve ResolveFunc[int], reject RejectFunc) {
+			resolve(10)
+		}))
+	}
+}
+
+func BenchmarkThenChain(b *testing.B) {
+	increment := Then(func(value int) (int, error) {
+		return value + 1, nil
+	})
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		promise := Resolve(0)
+		for j := 0; j < 10; j++ {
+			promise = promise.With(increment)
+		}
+		_, _ = Await[int](promise)
+	}
+}
+
+func BenchmarkAwaitSettled(b *testing.B) {
+	promise := Resolve(10)
+	_, _ = promise.Await()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = promise.Await()
+	}
+}
+
+func BenchmarkAwaitAfterReset(b *testing.B) {
+	promise := Resolve(10)
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, _ = promise.Await()
+		promise.Reset()
+	}
+}
+
+func BenchmarkAll(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		promises := make(Promises, 0, 10)
+		for j := 0; j < 10; j++ {
+			promises = append(promises, Resolve(j))
+		}
+		_, _ = Await[[]int](All[int](promises))
+	}
+}
@@ -0,0 +1,215 @@
+package go_promise
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var CircuitOpenErr = errors.New("promise.circuitOpen")
+
+type CircuitState int
+
+const (
+	CircuitClosed CircuitState = iota
+	CircuitOpen
+	CircuitHalfOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitOpen:
+		return "open"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "unknown"
+	}
+}
+
+type StateChangeFunc func(from CircuitState, to CircuitState)
+
+type CircuitBreakerSettings struct {
+	FailureThreshold int
+	CoolDown         time.Duration
+	HalfOpenProbes   int
+	IsFailure        func(err error) bool
+	OnStateChange    StateChangeFunc
+}
+
+const (
+	defaultFailureThreshold = 5
+	defaultCoolDown         = time.Minute
+	defaultHalfOpenProbes   = 1
+)
+
+type CircuitBreaker struct {
+	mutex      *sync.Mutex
+	settings   CircuitBreakerSettings
+	now        func() time.Time
+	state      CircuitState
+	generation uint64
+	failures   int
+	probes     int
+	successes  int
+	openedAt   time.Time
+}
+
+func NewCircuitBreaker(settings CircuitBreakerSettings) *CircuitBreaker {
+	if settings.FailureThreshold <= 0 {
+		settings.FailureThreshold = defaultFailureThreshold
+	}
+	if settings.CoolDown <= 0 {
+		settings.CoolDown = defaultCoolDown
+	}
+	if settings.HalfOpenProbes <= 0 {
+		settings.HalfOpenProbes = defaultHalfOpenProbes
+	}
+	if settings.IsFailure == nil {
+		settings.IsFailure = func(err error) bool {
+			return err != nil
+		}
+	}
+
+	return &CircuitBreaker{
+		mutex:    &sync.Mutex{},
+		settings: settings,
+		now:      time.Now,
+		state:    CircuitClosed,
+	}
+}
+
+func (cb *CircuitBreaker) State() CircuitState {
+	cb.mutex.Lock()
+	state, from, changed := cb.currentState()
+	cb.mutex.Unlock()
+
+	if changed {
+		cb.notify(from, state)
+	}
+
+	return state
+}
+
+func (cb *CircuitBreaker) Execute(factory func() Promise) Promise {
+	return New(func(resolve ResolveFunc[any], reject RejectFunc) {
+		generation, err := cb.before()
+		if err != nil {
+			reject(err)
+			return
+		}
+
+		value, err := factory().Await()
+		cb.after(generation, err)
+		if err != nil {
+			reject(err)
+			return
+		}
+
+		resolve(value)
+	})
+}
+
+func (cb *CircuitBreaker) before() (uint64, error) {
+	cb.mutex.Lock()
+	state, from, changed := cb.currentState()
+
+	var err error
+	switch state {
+	case CircuitOpen:
+		err = CircuitOpenErr
+	case CircuitHalfOpen:
+		if cb.probes >= cb.settings.HalfOpenProbes {
+			err = CircuitOpenErr
+		} else {
+			cb.probes++
+		}
+	}
+	generation := cb.generation
+	cb.mutex.Unlock()
+
+	if changed {
+		cb.notify(from, state)
+	}
+
+	return generation, err
+}
+
+func (cb *CircuitBreaker) after(generation uint64, err error) {
+	cb.mutex.Lock()
+	state, from, changed := cb.currentState()
+	if generation != cb.generation {
+		cb.mutex.Unlock()
+		if changed {
+			cb.notify(from, state)
+		}
+		return
+	}
+
+	to := state
+	if cb.settings.IsFailure(err) {
+		switch state {
+		case CircuitClosed:
+			cb.failures++
+			if cb.failures >= cb.settings.FailureThreshold {
+				to = CircuitOpen
+			}
+		case CircuitHalfOpen:
+			to = CircuitOpen
+		}
+	} else {
+		switch state {
+		case CircuitClosed:
+			cb.failures = 0
+		case CircuitHalfOpen:
+			cb.successes++
+			if cb.successes >= cb.settings.HalfOpenProbes {
+				to = CircuitClosed
+			}
+		}
+	}
+
+	if to != state {
+		cb.setState(to)
+		if !changed {
+			from = state
+		}
+		changed = true
+	}
+	state = to
+	cb.mutex.Unlock()
+
+	if changed {
+		cb.notify(from, state)
+	}
+}
+
+func (cb *CircuitBreaker) currentState() (CircuitState, CircuitState, bool) {
+	from := cb.state
+	if cb.state == CircuitOpen && !cb.now().Before(cb.openedAt.Add(cb.settings.CoolDown)) {
+		cb.setState(CircuitHalfOpen)
+		return cb.state, from, true
+	}
+
+	return cb.state, from, false
+}
+
+func (cb *CircuitBreaker) setState(state CircuitState) {
+	cb.state = state
+	cb.generation++
+	cb.failures = 0
+	cb.probes = 0
+	cb.successes = 0
+
+	if state == CircuitOpen {
+		cb.openedAt = cb.now()
+	}
+}
+
+func (cb *CircuitBreaker) notify(from CircuitState, to CircuitState) {
+	if cb.settings.OnStateChange != nil && from != to {
+		cb.settings.OnStateChange(from, to)
+	}
+}
@@ -0,0 +1,243 @@
+package go_promise
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker_Execute(t *testing.T) {
+	t.Run("it should resolve through closed circuit", func(t *testing.T) {
+		breaker := NewCircuitBreaker(CircuitBreakerSettings{})
+
+		result, err := Await[int](breaker.Execute(func() Promise {
+			return Resolve(10)
+		}))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+		if breaker.State() != CircuitClosed {
+			t.Error("circuit is not closed")
+		}
+	})
+
+	t.Run("it should open after threshold", func(t *testing.T) {
+		expected := errors.New("error")
+		counter := 0
+		breaker := NewCircuitBreaker(CircuitBreakerSettings{
+			FailureThreshold: 3,
+		})
+
+		factory := func() Promise {
+			return Function(func() (int, error) {
+				counter++
+				return 0, expected
+			})
+		}
+
+		for i := 0; i < 3; i++ {
+			_, err := Await[int](breaker.Execute(factory))
+			if err != expected {
+				t.Error("error is not as expected")
+			}
+		}
+
+		result, err := Await[int](breaker.Execute(factory))
+		if err != CircuitOpenErr {
+			t.Error("circuit open error is expected")
+		}
+		if result != 0 {
+			t.Error("result is not 0")
+		}
+		if counter != 3 {
+			t.Error("counter is not 3")
+		}
+		if breaker.State() != CircuitOpen {
+			t.Error("circuit is not open")
+		}
+	})
+
+	t.Run("it should reset failures after success", func(t *testing.T) {
+		breaker := NewCircuitBreaker(CircuitBreakerSettings{
+			FailureThreshold: 2,
+		})
+
+		for i := 0; i < 5; i++ {
+			_, _ = Await[int](breaker.Execute(func() Promise {
+				return Reject(errors.New("error"))
+			}))
+			_, _ = Await[int](breaker.Execute(func() Promise {
+				return Resolve(10)
+			}))
+		}
+
+		if breaker.State() != CircuitClosed {
+			t.Error("circuit is not closed")
+		}
+	})
+
+	t.Run("it should ignore errors which are not failures", func(t *testing.T) {
+		ignored := errors.New("ignored")
+		breaker := NewCircuitBreaker(CircuitBreakerSettings{
+			FailureThreshold: 1,
+			IsFailure: func(err error) bool {
+				return err != nil && err != ignored
+			},
+		})
+
+		_, err := Await[int](breaker.Execute(func() Promise {
+			return Reject(ignored)
+		}))
+		if err != ignored {
+			t.Error("error is not as expected")
+		}
+		if breaker.State() != CircuitClosed {
+			t.Error("circuit is not closed")
+		}
+	})
+
+	t.Run("it should close after successful half-open probe", func(t *testing.T) {
+		now := time.Now()
+		var changes []CircuitState
+		breaker := NewCircuitBreaker(CircuitBreakerSettings{
+			FailureThreshold: 1,
+			CoolDown:         time.Second,
+			OnStateChange: func(from CircuitState, to CircuitState) {
+				changes = append(changes, to)
+			},
+		})
+		breaker.now = func() time.Time {
+			return now
+		}
+
+		_, _ = Await[int](breaker.Execute(func() Promise {
+			return Reject(errors.New("error"))
+		}))
+		if breaker.State() != CircuitOpen {
+			t.Error("circuit is not open")
+		}
+
+		now = now.Add(time.Second)
+		if breaker.State() != CircuitHalfOpen {
+			t.Error("circuit is not half-open")
+		}
+
+		result, err := Await[int](breaker.Execute(func() Promise {
+			return Resolve(10)
+		}))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+		if breaker.State() != CircuitClosed {
+			t.Error("circuit is not closed")
+		}
+
+		expected := []CircuitState{CircuitOpen, CircuitHalfOpen, CircuitClosed}
+		if len(changes) != len(expected) {
+			t.Fatalf("state changes are not as expected: %v", changes)
+		}
+		for i := range expected {
+			if changes[i] != expected[i] {
+				t.Errorf("state change %d is not %s", i, expected[i])
+			}
+		}
+	})
+
+	t.Run("it should open again after failed half-open probe", func(t *testing.T) {
+		now := time.Now()
+		breaker := NewCircuitBreaker(CircuitBreakerSettings{
+			FailureThreshold: 1,
+			CoolDown:         time.Second,
+		})
+		breaker.now = func() time.Time {
+			return now
+		}
+
+		_, _ = Await[int](breaker.Execute(func() Promise {
+			return Reject(errors.New("error"))
+		}))
+
+		now = now.Add(time.Second)
+		_, _ = Await[int](breaker.Execute(func() Promise {
+			return Reject(errors.New("error"))
+		}))
+		if breaker.State() != CircuitOpen {
+			t.Error("circuit is not open")
+		}
+	})
+
+	t.Run("it should limit concurrent half-open probes", func(t *testing.T) {
+		now := time.Now()
+		breaker := NewCircuitBreaker(CircuitBreakerSettings{
+			FailureThreshold: 1,
+			CoolDown:         time.Second,
+		})
+		breaker.now = func() time.Time {
+			return now
+		}
+
+		_, _ = Await[int](breaker.Execute(func() Promise {
+			return Reject(errors.New("error"))
+		}))
+		now = now.Add(time.Second)
+
+		started := make(chan bool)
+		release := make(chan bool)
+		probe := breaker.Execute(func() Promise {
+			return Function(func() (int, error) {
+				started <- true
+				<-release
+				return 10, nil
+			})
+		})
+
+		go func() {
+			_, _ = Await[int](probe)
+		}()
+		<-started
+
+		_, err := Await[int](breaker.Execute(func() Promise {
+			return Resolve(10)
+		}))
+		if err != CircuitOpenErr {
+			t.Error("circuit open error is expected")
+		}
+
+		close(release)
+	})
+
+	t.Run("it should compose with retry, timeout and chaining", func(t *testing.T) {
+		tried := 0
+		breaker := NewCircuitBreaker(CircuitBreakerSettings{
+			FailureThreshold: 5,
+		})
+
+		promise := WithTimeout[int](WithRetry[int](breaker.Execute(func() Promise {
+			return Function(func() (int, error) {
+				if tried < 2 {
+					tried++
+					return 0, errors.New("error")
+				}
+				return 10, nil
+			})
+		}), 3), time.Minute).With(Then(func(value int) (float64, error) {
+			return float64(value), nil
+		})).With(Catch(func(err error) float64 {
+			return 0
+		}))
+
+		result, err := Await[float64](promise)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10.0 {
+			t.Error("result is not 10")
+		}
+	})
+}
@@ -0,0 +1,80 @@
+package go_promise
+
+import (
+	"errors"
+)
+
+var BulkheadFullErr = errors.New("promise.bulkheadFull")
+
+type Bulkhead struct {
+	slots chan struct{}
+	queue chan struct{}
+}
+
+func NewBulkhead(capacity int, queueSize int) *Bulkhead {
+	if capacity < 1 {
+		capacity = 1
+	}
+	if queueSize < 0 {
+		queueSize = 0
+	}
+
+	return &Bulkhead{
+		slots: make(chan struct{}, capacity),
+		queue: make(chan struct{}, queueSize),
+	}
+}
+
+func (b *Bulkhead) Active() int {
+	return len(b.slots)
+}
+
+func (b *Bulkhead) Queued() int {
+	return len(b.queue)
+}
+
+func (b *Bulkhead) Go(factory func() Promise) Promise {
+	return New(func(resolve ResolveFunc[any], reject RejectFunc) {
+		if !b.acquire() {
+			reject(BulkheadFullErr)
+			return
+		}
+
+		value, err := b.run(factory)
+		if err != nil {
+			reject(err)
+			return
+		}
+
+		resolve(value)
+	})
+}
+
+func (b *Bulkhead) run(factory func() Promise) (any, error) {
+	defer func() {
+		<-b.slots
+	}()
+
+	return factory().Await()
+}
+
+func (b *Bulkhead) acquire() bool {
+	select {
+	case b.slots <- struct{}{}:
+		return true
+	default:
+	}
+
+	select {
+	case b.queue <- struct{}{}:
+	default:
+		return false
+	}
+
+	resume := suspend()
+	b.slots <- struct{}{}
+	resume()
+	<-b.queue
+
+	return true
+}
@@ -0,0 +1,157 @@
+package go_promise
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBulkhead_Go(t *testing.T) {
+	t.Run("it should resolve int", func(t *testing.T) {
+		bulkhead := NewBulkhead(1, 0)
+
+		result, err := Await[int](bulkhead.Go(func() Promise {
+			return Resolve(10)
+		}))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+		if bulkhead.Active() != 0 {
+			t.Error("slot is not released")
+		}
+	})
+
+	t.Run("it should reject error", func(t *testing.T) {
+		expected := errors.New("error")
+		bulkhead := NewBulkhead(1, 0)
+
+		_, err := Await[int](bulkhead.Go(func() Promise {
+			return Reject(expected)
+		}))
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+		if bulkhead.Active() != 0 {
+			t.Error("slot is not released")
+		}
+	})
+
+	t.Run("it should reject when queue is full", func(t *testing.T) {
+		bulkhead := NewBulkhead(1, 1)
+		started := make(chan bool)
+		release := make(chan bool)
+
+		blocking := bulkhead.Go(func() Promise {
+			return Function(func() (int, error) {
+				started <- true
+				<-release
+				return 10, nil
+			})
+		})
+		queued := bulkhead.Go(func() Promise {
+			return Resolve(11)
+		})
+
+		group := &sync.WaitGroup{}
+		group.Add(2)
+		go func() {
+			_, _ = Await[int](blocking)
+			group.Done()
+		}()
+		<-started
+
+		go func() {
+			result, err := Await[int](queued)
+			if err != nil {
+				t.Error("error is not expected")
+			}
+			if result != 11 {
+				t.Error("result is not 11")
+			}
+			group.Done()
+		}()
+		for bulkhead.Queued() != 1 {
+			time.Sleep(time.Millisecond)
+		}
+
+		_, err := Await[int](bulkhead.Go(func() Promise {
+			return Resolve(12)
+		}))
+		if err != BulkheadFullErr {
+			t.Error("bulkhead full error is expected")
+		}
+
+		close(release)
+		group.Wait()
+
+		if bulkhead.Active() != 0 || bulkhead.Queued() != 0 {
+			t.Error("bulkhead is not empty")
+		}
+	})
+
+	t.Run("it should not exceed capacity", func(t *testing.T) {
+		bulkhead := NewBulkhead(2, 10)
+		mutex := &sync.Mutex{}
+		running := 0
+		maximum := 0
+
+		promises := make(Promises, 0, 10)
+		for i := 0; i < 10; i++ {
+			promises = append(promises, bulkhead.Go(func() Promise {
+				return Function(func() (int, error) {
+					mutex.Lock()
+					running++
+					if running > maximum {
+						maximum = running
+					}
+					mutex.Unlock()
+
+					time.Sleep(10 * time.Millisecond)
+
+					mutex.Lock()
+					running--
+					mutex.Unlock()
+					return 10, nil
+				})
+			}))
+		}
+
+		result, err := Await[[]int](All[int](promises))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if len(result) != 10 {
+			t.Error("result does not have 10 values")
+		}
+		if maximum > 2 {
+			t.Error("capacity is exceeded")
+		}
+	})
+
+	t.Run("it should release slot when promise panics", func(t *testing.T) {
+		restore := SetExecutor(InlineExecutor)
+		defer restore()
+
+		bulkhead := NewBulkhead(1, 0)
+
+		func() {
+			defer func() {
+				_ = recover()
+			}()
+
+			_, _ = bulkhead.Go(func() Promise {
+				return Function(func() (int, error) {
+					panic("panic")
+				})
+			}).Await()
+		}()
+
+		if bulkhead.Active() != 0 {
+			t.Error("slot is not released")
+		}
+	})
+}
@@ -6,27 +6,21 @@ type ThenFunc[V, W any] func(value V) (W, error)
 
 func Then[V, W any](then ThenFunc[V, W]) ChainFunc {
 	return func(promise Promise) Promise {
-		return New(func(resolve ResolveFunc[W], reject RejectFunc) {
-			value, err := promise.await()
-			if err != nil {
-				reject(err)
-				return
-			}
+		return newSyncPromise(func() (W, error) {
+			var empty W
 
-			transformed, ok := value.(V)
-			if !ok {
-				reject(InvalidTypeErr)
-				return
+			value, err := promise.Await()
+			if err != nil {
+				return empty, err
 			}
 
-			result, err := then(transformed)
+			transformed, err := cast[V](promise, value)
 			if err != nil {
-				reject(err)
-				return
+				return empty, err
 			}
 
-			resolve(result)
-		})
+			return then(transformed)
+		}, promise, nil)
 	}
 }
 
@@ -34,20 +28,13 @@ type CatchFunc[V any] func(err error) V
 
 func Catch[V any](catch CatchFunc[V]) ChainFunc {
 	return func(promise Promise) Promise {
-		return New(func(resolve ResolveFunc[V], reject RejectFunc) {
-			value, err := promise.await()
+		return newSyncPromise(func() (V, error) {
+			value, err := promise.Await()
 			if err != nil {
-				resolve(catch(err))
-				return
-			}
-
-			transformed, ok := value.(V)
-			if !ok {
-				reject(InvalidTypeErr)
-				return
+				return catch(err), nil
 			}
 
-			resolve(transformed)
-		})
+			return cast[V](promise, value)
+		}, promise, nil)
 	}
 }
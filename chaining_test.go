@@ -48,7 +48,7 @@ func TestThen(t *testing.T) {
 		}))
 
 		result, err := Await[float64](promise)
-		if err != InvalidTypeErr {
+		if !errors.Is(err, InvalidTypeErr) {
 			t.Error("error is not as expected")
 		}
 		if result != 0.0 {
@@ -64,7 +64,7 @@ func TestThen(t *testing.T) {
 		}))
 
 		result, err := Await[int](promise)
-		if err != InvalidTypeErr {
+		if !errors.Is(err, InvalidTypeErr) {
 			t.Error("error is not as expected")
 		}
 		if result != 0.0 {
@@ -137,7 +137,7 @@ func TestCatch(t *testing.T) {
 		}))
 
 		result, err := Await[float64](promise)
-		if err != InvalidTypeErr {
+		if !errors.Is(err, InvalidTypeErr) {
 			t.Error("error is expected")
 		}
 		if result != 0 {
@@ -153,7 +153,7 @@ func TestCatch(t *testing.T) {
 		}))
 
 		result, err := Await[float64](promise)
-		if err != InvalidTypeErr {
+		if !errors.Is(err, InvalidTypeErr) {
 			t.Error("error is expected")
 		}
 		if result != 0 {
@@ -0,0 +1,82 @@
+package go_promise
+
+import (
+	"errors"
+)
+
+var ClosedChannelErr = errors.New("promise.closedChannel")
+
+func FromChan[V any](channel <-chan V, options ...Option) Promise {
+	return New(func(resolve ResolveFunc[V], reject RejectFunc) {
+		resume := suspend()
+		value, ok := <-channel
+		resume()
+		if !ok {
+			reject(ClosedChannelErr)
+			return
+		}
+
+		resolve(value)
+	}, options...)
+}
+
+func FromErrChan[V any](channel <-chan V, errChan <-chan error, options ...Option) Promise {
+	return New(func(resolve ResolveFunc[V], reject RejectFunc) {
+		defer suspend()()
+
+		for {
+			select {
+			case value, ok := <-channel:
+				if !ok {
+					reject(pendingError(errChan))
+					return
+				}
+
+				resolve(value)
+				return
+			case err, ok := <-errChan:
+				if !ok {
+					errChan = nil
+					continue
+				}
+				if err == nil {
+					continue
+				}
+
+				reject(err)
+				return
+			}
+		}
+	}, options...)
+}
+
+func pendingError(errChan <-chan error) error {
+	for {
+		select {
+		case err, ok := <-errChan:
+			if !ok {
+				return ClosedChannelErr
+			}
+			if err != nil {
+				return err
+			}
+		default:
+			return ClosedChannelErr
+		}
+	}
+}
+
+func ToChan[V any](promise Awaiter) <-chan SettledResult[V] {
+	resultChan := make(chan SettledResult[V], 1)
+
+	executorOf(promise).Submit(func() {
+		value, err := Await[V](promise)
+		resultChan <- SettledResult[V]{
+			Value: value,
+			Error: err,
+		}
+		close(resultChan)
+	})
+
+	return resultChan
+}
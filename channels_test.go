@@ -0,0 +1,137 @@
+package go_promise
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestFromChan(t *testing.T) {
+	t.Run("it should resolve first value", func(t *testing.T) {
+		channel := make(chan int, 2)
+		channel <- 10
+		channel <- 11
+
+		result, err := Await[int](FromChan(channel))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+	})
+
+	t.Run("it should reject closed channel", func(t *testing.T) {
+		channel := make(chan int)
+		close(channel)
+
+		_, err := Await[int](FromChan(channel))
+		if err != ClosedChannelErr {
+			t.Error("closed channel error is expected")
+		}
+	})
+}
+
+func TestFromErrChan(t *testing.T) {
+	t.Run("it should resolve value", func(t *testing.T) {
+		channel := make(chan int, 1)
+		errChan := make(chan error)
+		channel <- 10
+
+		result, err := Await[int](FromErrChan(channel, errChan))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+	})
+
+	t.Run("it should reject error", func(t *testing.T) {
+		expected := errors.New("error")
+		channel := make(chan int)
+		errChan := make(chan error, 1)
+		errChan <- expected
+
+		_, err := Await[int](FromErrChan(channel, errChan))
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should reject error sent before channel is closed", func(t *testing.T) {
+		for i := 0; i < 100; i++ {
+			expected := errors.New("error")
+			channel := make(chan int)
+			errChan := make(chan error, 1)
+			errChan <- expected
+			close(channel)
+
+			_, err := Await[int](FromErrChan(channel, errChan))
+			if err != expected {
+				t.Fatal("error is not as expected")
+			}
+		}
+	})
+
+	t.Run("it should ignore closed error channel", func(t *testing.T) {
+		channel := make(chan int)
+		errChan := make(chan error)
+		close(errChan)
+
+		go func() {
+			channel <- 10
+		}()
+
+		result, err := Await[int](FromErrChan(channel, errChan))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+	})
+
+	t.Run("it should reject closed channel", func(t *testing.T) {
+		channel := make(chan int)
+		close(channel)
+
+		_, err := Await[int](FromErrChan(channel, make(chan error)))
+		if err != ClosedChannelErr {
+			t.Error("closed channel error is expected")
+		}
+	})
+}
+
+func TestToChan(t *testing.T) {
+	t.Run("it should send value", func(t *testing.T) {
+		resultChan := ToChan[int](Resolve(10))
+
+		result := <-resultChan
+		if result.Error != nil {
+			t.Error("error is not expected")
+		}
+		if result.Value != 10 {
+			t.Error("result is not 10")
+		}
+
+		if _, ok := <-resultChan; ok {
+			t.Error("channel is not closed")
+		}
+	})
+
+	t.Run("it should send error", func(t *testing.T) {
+		expected := errors.New("error")
+
+		result := <-ToChan[int](Reject(expected))
+		if result.Error != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should send invalid type", func(t *testing.T) {
+		result := <-ToChan[bool](Resolve(10))
+		if !errors.Is(result.Error, InvalidTypeErr) {
+			t.Error("error is not as expected")
+		}
+	})
+}
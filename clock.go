@@ -0,0 +1,28 @@
+package go_promise
+
+import (
+	"time"
+)
+
+type Clock interface {
+	Now() time.Time
+	After(duration time.Duration) <-chan time.Time
+}
+
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (systemClock) After(duration time.Duration) <-chan time.Time {
+	return time.After(duration)
+}
+
+func WithClock(clock Clock) Option {
+	return func(meta *metadata) {
+		meta.clock = clock
+	}
+}
@@ -0,0 +1,73 @@
+package go_promise
+
+import (
+	"sync"
+	"time"
+)
+
+func Debounce[V any](fn PromiseFunc[V], wait time.Duration) func() Promise {
+	mutex := &sync.Mutex{}
+	var current Promise
+	var ready chan struct{}
+	var sequence uint64
+
+	return func() Promise {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if current == nil {
+			inner := Function(fn)
+			trigger := make(chan struct{})
+			ready = trigger
+
+			current = New(func(resolve ResolveFunc[V], reject RejectFunc) {
+				<-trigger
+				settle[V](inner, resolve, reject)
+			})
+		}
+
+		sequence++
+		expected := sequence
+		shared := current
+		time.AfterFunc(wait, func() {
+			mutex.Lock()
+			if sequence != expected || current != shared {
+				mutex.Unlock()
+				return
+			}
+			close(ready)
+			current = nil
+			mutex.Unlock()
+
+			_, _ = awaitSilently(shared)
+		})
+
+		return shared
+	}
+}
+
+func Throttle[V any](fn PromiseFunc[V], interval time.Duration) func() Promise {
+	mutex := &sync.Mutex{}
+	var current Promise
+	var startedAt time.Time
+
+	return func() Promise {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		now := time.Now()
+		if current != nil && now.Sub(startedAt) < interval {
+			return current
+		}
+
+		shared := Function(fn)
+		current = shared
+		startedAt = now
+
+		executorOf(shared).Submit(func() {
+			_, _ = awaitSilently(shared)
+		})
+
+		return shared
+	}
+}
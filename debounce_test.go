@@ -0,0 +1,157 @@
+package go_promise
+
+import (
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestDebounce(t *testing.T) {
+	t.Run("it should share one execution in a burst", func(t *testing.T) {
+		var counter int32
+
+		debounced := Debounce(func() (int, error) {
+			return int(atomic.AddInt32(&counter, 1)), nil
+		}, 20*time.Millisecond)
+
+		promises := make(Promises, 0, 5)
+		for i := 0; i < 5; i++ {
+			promises = append(promises, debounced())
+			time.Sleep(5 * time.Millisecond)
+		}
+
+		result, err := Await[[]int](All[int](promises))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		for _, value := range result {
+			if value != 1 {
+				t.Error("result is not 1")
+			}
+		}
+		if atomic.LoadInt32(&counter) != 1 {
+			t.Error("counter is not 1")
+		}
+	})
+
+	t.Run("it should wait for the quiet period", func(t *testing.T) {
+		debounced := Debounce(func() (int, error) {
+			return 10, nil
+		}, 50*time.Millisecond)
+
+		start := time.Now()
+		debounced()
+		time.Sleep(30 * time.Millisecond)
+
+		result, err := Await[int](debounced())
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+		if time.Since(start) < 80*time.Millisecond {
+			t.Error("promise is not debounced")
+		}
+	})
+
+	t.Run("it should execute again after the window", func(t *testing.T) {
+		var counter int32
+
+		debounced := Debounce(func() (int, error) {
+			return int(atomic.AddInt32(&counter, 1)), nil
+		}, 10*time.Millisecond)
+
+		first, _ := Await[int](debounced())
+		second, _ := Await[int](debounced())
+		if first != 1 || second != 2 {
+			t.Error("results are not 1 and 2")
+		}
+	})
+
+	t.Run("it should execute without awaiting", func(t *testing.T) {
+		executed := make(chan bool, 1)
+
+		debounced := Debounce(func() (int, error) {
+			executed <- true
+			return 10, nil
+		}, 10*time.Millisecond)
+		debounced()
+
+		select {
+		case <-executed:
+		case <-time.After(time.Second):
+			t.Error("promise is not executed")
+		}
+	})
+
+	t.Run("it should reject error", func(t *testing.T) {
+		expected := errors.New("error")
+
+		debounced := Debounce(func() (int, error) {
+			return 0, expected
+		}, 10*time.Millisecond)
+
+		_, err := Await[int](debounced())
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+}
+
+func TestThrottle(t *testing.T) {
+	t.Run("it should share one execution in an interval", func(t *testing.T) {
+		var counter int32
+
+		throttled := Throttle(func() (int, error) {
+			return int(atomic.AddInt32(&counter, 1)), nil
+		}, time.Minute)
+
+		promises := make(Promises, 0, 5)
+		for i := 0; i < 5; i++ {
+			promises = append(promises, throttled())
+		}
+
+		result, err := Await[[]int](All[int](promises))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		for _, value := range result {
+			if value != 1 {
+				t.Error("result is not 1")
+			}
+		}
+		if atomic.LoadInt32(&counter) != 1 {
+			t.Error("counter is not 1")
+		}
+	})
+
+	t.Run("it should execute again after the interval", func(t *testing.T) {
+		var counter int32
+
+		throttled := Throttle(func() (int, error) {
+			return int(atomic.AddInt32(&counter, 1)), nil
+		}, 10*time.Millisecond)
+
+		first, _ := Await[int](throttled())
+		time.Sleep(20 * time.Millisecond)
+		second, _ := Await[int](throttled())
+		if first != 1 || second != 2 {
+			t.Error("results are not 1 and 2")
+		}
+	})
+
+	t.Run("it should reject error", func(t *testing.T) {
+		expected := errors.New("error")
+
+		throttled := Throttle(func() (int, error) {
+			return 0, expected
+		}, time.Minute)
+
+		_, err := Await[int](throttled())
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+}
@@ -0,0 +1,307 @@
+package go_promise
+
+import (
+	"bytes"
+	"fmt"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+func DetectCycles() func() {
+	return awaitGraph.detect()
+}
+
+var awaitGraph = &cycleDetector{
+	mutex:     &sync.Mutex{},
+	executing: map[int64][]*metadata{},
+	waiting:   map[*metadata]map[*metadata]int{},
+}
+
+type cycleDetector struct {
+	mutex     *sync.Mutex
+	executing map[int64][]*metadata
+	waiting   map[*metadata]map[*metadata]int
+	lastID    uint64
+	detecting int32
+}
+
+func (d *cycleDetector) detect() func() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	d.lastID++
+	id := d.lastID
+	atomic.StoreInt32(&d.detecting, 1)
+
+	return func() {
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+
+		if d.lastID != id {
+			return
+		}
+		atomic.StoreInt32(&d.detecting, 0)
+	}
+}
+
+func (d *cycleDetector) isDetecting() bool {
+	return atomic.LoadInt32(&d.detecting) == 1
+}
+
+func (d *cycleDetector) enter(target *metadata) (func(), error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	owner := d.current(goroutineID())
+	if owner == nil {
+		return func() {}, nil
+	}
+
+	if path := d.path(target, owner, map[*metadata]bool{}); path != nil {
+		chain := []string{owner.label()}
+		for _, meta := range path {
+			chain = append(chain, meta.label())
+		}
+
+		return nil, &CycleError{
+			Chain: chain,
+		}
+	}
+
+	if d.waiting[owner] == nil {
+		d.waiting[owner] = map[*metadata]int{}
+	}
+	d.waiting[owner][target]++
+
+	return func() {
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+
+		d.waiting[owner][target]--
+		if d.waiting[owner][target] == 0 {
+			delete(d.waiting[owner], target)
+		}
+		if len(d.waiting[owner]) == 0 {
+			delete(d.waiting, owner)
+		}
+	}, nil
+}
+
+func (d *cycleDetector) run(meta *metadata) func() {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+
+	id := goroutineID()
+	d.executing[id] = append(d.executing[id], meta)
+
+	return func() {
+		d.mutex.Lock()
+		defer d.mutex.Unlock()
+
+		stack := d.executing[id]
+		if len(stack) <= 1 {
+			delete(d.executing, id)
+			return
+		}
+		d.executing[id] = stack[:len(stack)-1]
+	}
+}
+
+func (d *cycleDetector) inherit(task func()) func() {
+	d.mutex.Lock()
+	owner := d.current(goroutineID())
+	d.mutex.Unlock()
+
+	if owner == nil {
+		return task
+	}
+
+	return func() {
+		defer d.run(owner)()
+		task()
+	}
+}
+
+func (d *cycleDetector) current(id int64) *metadata {
+	stack := d.executing[id]
+	if len(stack) == 0 {
+		return nil
+	}
+
+	return stack[len(stack)-1]
+}
+
+func (d *cycleDetector) path(from *metadata, to *metadata, visited map[*metadata]bool) []*metadata {
+	if from == to {
+		return []*metadata{from}
+	}
+	if visited[from] {
+		return nil
+	}
+	visited[from] = true
+
+	for next := range d.waiting[from] {
+		if path := d.path(next, to, visited); path != nil {
+			return append([]*metadata{from}, path...)
+		}
+	}
+
+	return nil
+}
+
+func goroutineID() int64 {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	fields := bytes.Fields(buf[:n])
+	if len(fields) < 2 {
+		return 0
+	}
+
+	id, _ := strconv.ParseInt(string(fields[1]), 10, 64)
+	return id
+}
+
+func (m *metadata) label() string {
+	if m.name != "" {
+		return m.name
+	}
+
+	return fmt.Sprintf("#%d", m.id)
+}
+
+type PendingPromise struct {
+	ID       uint64
+	Name     string
+	Duration time.Duration
+	Stack    string
+}
+
+type PendingPromiseHandler func(pending PendingPromise)
+
+func Watchdog(threshold time.Duration, handler PendingPromiseHandler) func() {
+	return pendingPromises.watch(threshold, handler)
+}
+
+var pendingPromises = &watchdog{
+	mutex:   &sync.Mutex{},
+	pending: map[*metadata]*pendingExecution{},
+}
+
+type watchdog struct {
+	mutex    *sync.Mutex
+	pending  map[*metadata]*pendingExecution
+	lastID   uint64
+	stop     chan struct{}
+	watching int32
+}
+
+type pendingExecution struct {
+	startedAt  time.Time
+	isReported bool
+}
+
+func (w *watchdog) watch(threshold time.Duration, handler PendingPromiseHandler) func() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if w.stop != nil {
+		close(w.stop)
+	}
+
+	w.lastID++
+	id := w.lastID
+	stop := make(chan struct{})
+	w.stop = stop
+	atomic.StoreInt32(&w.watching, 1)
+
+	interval := threshold / 2
+	if interval < time.Millisecond {
+		interval = time.Millisecond
+	}
+	go w.check(threshold, interval, handler, stop)
+
+	return func() {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+
+		if w.lastID != id {
+			return
+		}
+		close(w.stop)
+		w.stop = nil
+		w.pending = map[*metadata]*pendingExecution{}
+		atomic.StoreInt32(&w.watching, 0)
+	}
+}
+
+func (w *watchdog) isWatching() bool {
+	return atomic.LoadInt32(&w.watching) == 1
+}
+
+func (w *watchdog) start(meta *metadata, startedAt time.Time) func() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	execution := &pendingExecution{
+		startedAt: startedAt,
+	}
+	w.pending[meta] = execution
+
+	return func() {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+
+		if w.pending[meta] == execution {
+			delete(w.pending, meta)
+		}
+	}
+}
+
+func (w *watchdog) check(threshold time.Duration, interval time.Duration, handler PendingPromiseHandler, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			for _, pending := range w.overdue(now, threshold) {
+				handler(pending)
+			}
+		}
+	}
+}
+
+func (w *watchdog) overdue(now time.Time, threshold time.Duration) []PendingPromise {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	overdue := []PendingPromise{}
+	for meta, execution := range w.pending {
+		duration := now.Sub(execution.startedAt)
+		if execution.isReported || duration < threshold {
+			continue
+		}
+
+		execution.isReported = true
+		overdue = append(overdue, PendingPromise{
+			ID:       meta.id,
+			Name:     meta.name,
+			Duration: duration,
+			Stack:    meta.stack,
+		})
+	}
+
+	return overdue
+}
+
+func creationStack() string {
+	buf := make([]byte, 4096)
+	n := runtime.Stack(buf, false)
+
+	return string(buf[:n])
+}
@@ -0,0 +1,176 @@
+package go_promise
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDetectCycles(t *testing.T) {
+	t.Run("it should reject self await in executor", func(t *testing.T) {
+		stop := DetectCycles()
+		defer stop()
+
+		var self Promise
+		self = New(func(resolve ResolveFunc[int], reject RejectFunc) {
+			_, err := self.Await()
+			if err != nil {
+				reject(err)
+				return
+			}
+			resolve(10)
+		})
+
+		_, err := Await[int](self)
+		if !errors.Is(err, CycleErr) {
+			t.Error("cycle error is expected")
+		}
+	})
+
+	t.Run("it should reject self await in then callback", func(t *testing.T) {
+		stop := DetectCycles()
+		defer stop()
+
+		var chained Promise
+		chained = Resolve(10).With(Then(func(value int) (int, error) {
+			_, err := chained.Await()
+			return value, err
+		}))
+
+		_, err := Await[int](chained)
+		if !errors.Is(err, CycleErr) {
+			t.Error("cycle error is expected")
+		}
+	})
+
+	t.Run("it should describe the chain", func(t *testing.T) {
+		stop := DetectCycles()
+		defer stop()
+
+		var first, second Promise
+		first = New(func(resolve ResolveFunc[int], reject RejectFunc) {
+			settle[int](second, resolve, reject)
+		}, Named("first"))
+		second = New(func(resolve ResolveFunc[int], reject RejectFunc) {
+			settle[int](first, resolve, reject)
+		}, Named("second"))
+
+		_, err := Await[int](first)
+		var cycle *CycleError
+		if !errors.As(err, &cycle) {
+			t.Fatal("cycle error is expected")
+		}
+		if !reflect.DeepEqual(cycle.Chain, []string{"second", "first", "second"}) {
+			t.Error("chain is not as expected")
+		}
+		if !strings.Contains(err.Error(), "promise.cycle: second -> first -> second") {
+			t.Error("message is not as expected")
+		}
+	})
+
+	t.Run("it should detect cycles through resolvers", func(t *testing.T) {
+		stop := DetectCycles()
+		defer stop()
+
+		var all Promise
+		all = All[int](Promises{
+			Resolve(10),
+			New(func(resolve ResolveFunc[int], reject RejectFunc) {
+				settle[int](all, resolve, reject)
+			}),
+		})
+
+		_, err := Await[[]int](all)
+		if !errors.Is(err, CycleErr) {
+			t.Error("cycle error is expected")
+		}
+	})
+
+	t.Run("it should not reject promises without cycle", func(t *testing.T) {
+		stop := DetectCycles()
+		defer stop()
+
+		shared := Resolve(10)
+		promise := All[int](Promises{
+			shared.With(Then(func(value int) (int, error) {
+				return value + 1, nil
+			})),
+			shared,
+			WithTimeout[int](shared, time.Minute),
+		})
+
+		result, err := Await[[]int](promise)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if len(result) != 3 {
+			t.Error("result length is not 3")
+		}
+	})
+
+	t.Run("it should stop detecting", func(t *testing.T) {
+		stop := DetectCycles()
+		stop()
+
+		if awaitGraph.isDetecting() {
+			t.Error("cycles are detected")
+		}
+	})
+}
+
+func TestWatchdog(t *testing.T) {
+	t.Run("it should report pending promise once", func(t *testing.T) {
+		reports := make(chan PendingPromise, 10)
+		stop := Watchdog(10*time.Millisecond, func(pending PendingPromise) {
+			reports <- pending
+		})
+		defer stop()
+
+		slow := Function(func() (int, error) {
+			time.Sleep(60 * time.Millisecond)
+			return 10, nil
+		}, Named("slow"))
+		fast := Resolve(10)
+
+		_, _ = Await[int](fast)
+		_, _ = Await[int](slow)
+
+		select {
+		case pending := <-reports:
+			if pending.Name != "slow" {
+				t.Error("pending promise is not slow")
+			}
+			if pending.Duration < 10*time.Millisecond {
+				t.Error("duration is not above threshold")
+			}
+			if !strings.Contains(pending.Stack, "debug_test.go") {
+				t.Error("stack does not contain creation place")
+			}
+		default:
+			t.Fatal("pending promise is not reported")
+		}
+
+		if len(reports) != 0 {
+			t.Error("pending promise is reported more than once")
+		}
+	})
+
+	t.Run("it should not report after stop", func(t *testing.T) {
+		reports := make(chan PendingPromise, 10)
+		stop := Watchdog(5*time.Millisecond, func(pending PendingPromise) {
+			reports <- pending
+		})
+		stop()
+
+		_, _ = Await[int](Function(func() (int, error) {
+			time.Sleep(30 * time.Millisecond)
+			return 10, nil
+		}))
+
+		if len(reports) != 0 {
+			t.Error("pending promise is reported")
+		}
+	})
+}
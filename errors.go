@@ -0,0 +1,109 @@
+package go_promise
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var (
+	InvalidTypeErr = errors.New("promise.invalidType")
+	CycleErr       = errors.New("promise.cycle")
+)
+
+type TypeError struct {
+	Name     string
+	Expected string
+	Actual   string
+}
+
+func (e *TypeError) Error() string {
+	message := fmt.Sprintf("%s: expected %s, got %s", InvalidTypeErr, e.Expected, e.Actual)
+	if e.Name == "" {
+		return message
+	}
+
+	return fmt.Sprintf("%s: %s", e.Name, message)
+}
+
+func (e *TypeError) Is(target error) bool {
+	return target == InvalidTypeErr
+}
+
+type CycleError struct {
+	Chain []string
+}
+
+func (e *CycleError) Error() string {
+	return fmt.Sprintf("%s: %s", CycleErr, strings.Join(e.Chain, " -> "))
+}
+
+func (e *CycleError) Is(target error) bool {
+	return target == CycleErr
+}
+
+type NamedError struct {
+	Name string
+	Err  error
+}
+
+func (e *NamedError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Name, e.Err)
+}
+
+func (e *NamedError) Unwrap() error {
+	return e.Err
+}
+
+func newTypeError[V any](name string, value any) error {
+	actual := "<nil>"
+	if value != nil {
+		actual = reflect.TypeOf(value).String()
+	}
+
+	return &TypeError{
+		Name:     name,
+		Expected: reflect.TypeOf((*V)(nil)).Elem().String(),
+		Actual:   actual,
+	}
+}
+
+func withName(name string, err error) error {
+	if name == "" || err == nil {
+		return err
+	}
+
+	var named *NamedError
+	if errors.As(err, &named) && named.Name == name {
+		return err
+	}
+
+	var typed *TypeError
+	if errors.As(err, &typed) && typed.Name == name {
+		return err
+	}
+
+	return &NamedError{
+		Name: name,
+		Err:  err,
+	}
+}
+
+func nameOf(promise Awaiter) string {
+	named, ok := promise.(observable)
+	if !ok {
+		return ""
+	}
+
+	return named.metadata().name
+}
+
+func cast[V any](promise Awaiter, value any) (V, error) {
+	transformed, ok := value.(V)
+	if !ok {
+		return transformed, newTypeError[V](nameOf(promise), value)
+	}
+
+	return transformed, nil
+}
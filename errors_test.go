@@ -0,0 +1,131 @@
+package go_promise
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTypeError(t *testing.T) {
+	t.Run("it should describe types", func(t *testing.T) {
+		_, err := Await[bool](Resolve(10))
+
+		var typed *TypeError
+		if !errors.As(err, &typed) {
+			t.Fatal("type error is expected")
+		}
+		if typed.Expected != "bool" || typed.Actual != "int" {
+			t.Error("types are not as expected")
+		}
+		if err.Error() != "promise.invalidType: expected bool, got int" {
+			t.Errorf("message is not as expected: %s", err)
+		}
+		if !errors.Is(err, InvalidTypeErr) {
+			t.Error("error does not match sentinel")
+		}
+	})
+
+	t.Run("it should describe nil value", func(t *testing.T) {
+		_, err := Await[int](Resolve[any](nil))
+		if err.Error() != "promise.invalidType: expected int, got <nil>" {
+			t.Errorf("message is not as expected: %s", err)
+		}
+	})
+
+	t.Run("it should contain name", func(t *testing.T) {
+		_, err := Await[bool](Function(func() (int, error) {
+			return 10, nil
+		}, Named("fetchUser")))
+		if err.Error() != "fetchUser: promise.invalidType: expected bool, got int" {
+			t.Errorf("message is not as expected: %s", err)
+		}
+	})
+
+	t.Run("it should contain upstream name in then", func(t *testing.T) {
+		_, err := Await[float64](Function(func() (int, error) {
+			return 10, nil
+		}, Named("fetchUser")).With(Then(func(value string) (float64, error) {
+			return 0, nil
+		})))
+		if err.Error() != "fetchUser: promise.invalidType: expected string, got int" {
+			t.Errorf("message is not as expected: %s", err)
+		}
+	})
+
+	t.Run("it should contain name in combinators", func(t *testing.T) {
+		_, err := Await[[]int](All[int](Promises{
+			Resolve(10),
+			Function(func() (string, error) {
+				return "", nil
+			}, Named("fetchName")),
+		}))
+		if err.Error() != "fetchName: promise.invalidType: expected int, got string" {
+			t.Errorf("message is not as expected: %s", err)
+		}
+	})
+}
+
+func TestNamedError(t *testing.T) {
+	t.Run("it should wrap rejection", func(t *testing.T) {
+		expected := errors.New("error")
+
+		_, err := Await[int](Function(func() (int, error) {
+			return 0, expected
+		}, Named("fetchUser")).With(Then(func(value int) (int, error) {
+			return value, nil
+		})))
+		if err.Error() != "fetchUser: error" {
+			t.Errorf("message is not as expected: %s", err)
+		}
+		if !errors.Is(err, expected) {
+			t.Error("error does not match original")
+		}
+	})
+
+	t.Run("it should not wrap twice", func(t *testing.T) {
+		expected := errors.New("error")
+
+		_, err := Await[int](Function(func() (int, error) {
+			return 0, withName("fetchUser", expected)
+		}, Named("fetchUser")))
+		if err.Error() != "fetchUser: error" {
+			t.Errorf("message is not as expected: %s", err)
+		}
+	})
+
+	t.Run("it should not wrap without name", func(t *testing.T) {
+		expected := errors.New("error")
+
+		_, err := Await[int](Reject(expected))
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should contain name in timeout", func(t *testing.T) {
+		release := make(chan bool)
+		defer close(release)
+
+		_, err := Await[int](WithTimeout[int](Function(func() (int, error) {
+			<-release
+			return 10, nil
+		}, Named("fetchUser")), 0))
+		if err.Error() != "fetchUser: promise.timeout" {
+			t.Errorf("message is not as expected: %s", err)
+		}
+		if !errors.Is(err, TimeoutErr) {
+			t.Error("error does not match sentinel")
+		}
+	})
+
+	t.Run("it should contain name in retries", func(t *testing.T) {
+		_, err := Await[int](WithRetry[int](Function(func() (int, error) {
+			return 0, errors.New("error")
+		}, Named("fetchUser")), 1))
+		if err.Error() != "fetchUser: promise.maxRetries" {
+			t.Errorf("message is not as expected: %s", err)
+		}
+		if !errors.Is(err, MaxRetriesErr) {
+			t.Error("error does not match sentinel")
+		}
+	})
+}
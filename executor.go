@@ -0,0 +1,278 @@
+package go_promise
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type Executor interface {
+	Submit(task func())
+}
+
+var (
+	GoroutineExecutor Executor = goroutineExecutor{}
+	InlineExecutor    Executor = inlineExecutor{}
+)
+
+type goroutineExecutor struct{}
+
+func (goroutineExecutor) Submit(task func()) {
+	go task()
+}
+
+type inlineExecutor struct{}
+
+func (inlineExecutor) Submit(task func()) {
+	task()
+}
+
+var _ Executor = &WorkerPool{}
+
+const workerPoolQueueSize = 1024
+
+// WorkerPool runs at most the given number of tasks at a time and queues the
+// rest. A task waiting on another promise hands its slot to a new worker until
+// it continues, so nested promises cannot deadlock the pool.
+type WorkerPool struct {
+	size      int
+	tasks     chan func()
+	slots     chan struct{}
+	done      chan struct{}
+	once      *sync.Once
+	mutex     *sync.Mutex
+	workers   int
+	suspended int
+}
+
+func NewWorkerPool(workers int) *WorkerPool {
+	if workers < 1 {
+		workers = 1
+	}
+
+	pool := &WorkerPool{
+		size:    workers,
+		tasks:   make(chan func(), workerPoolQueueSize),
+		slots:   make(chan struct{}, workers),
+		done:    make(chan struct{}),
+		once:    &sync.Once{},
+		mutex:   &sync.Mutex{},
+		workers: workers,
+	}
+
+	ready := &sync.WaitGroup{}
+	ready.Add(workers)
+	for i := 0; i < workers; i++ {
+		go pool.work(ready.Done)
+	}
+	ready.Wait()
+
+	return pool
+}
+
+// Submit queues the task, and waits for free space when the queue is full.
+// Tasks submitted after Close run on their own goroutine.
+func (p *WorkerPool) Submit(task func()) {
+	if p.isClosed() {
+		go task()
+		return
+	}
+
+	select {
+	case p.tasks <- task:
+	default:
+		resume := suspend()
+		select {
+		case p.tasks <- task:
+		case <-p.done:
+			go task()
+		}
+		resume()
+	}
+
+	if p.isClosed() {
+		p.drain()
+	}
+}
+
+// Close stops the workers once the queued tasks are started.
+func (p *WorkerPool) Close() {
+	p.once.Do(func() {
+		close(p.done)
+	})
+}
+
+func (p *WorkerPool) isClosed() bool {
+	select {
+	case <-p.done:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *WorkerPool) work(ready func()) {
+	id := goroutineID()
+	poolWorkers.Store(id, &poolWorker{
+		pool: p,
+	})
+	atomic.AddInt32(&poolWorkerCount, 1)
+	defer func() {
+		poolWorkers.Delete(id)
+		atomic.AddInt32(&poolWorkerCount, -1)
+	}()
+	ready()
+
+	for {
+		select {
+		case task := <-p.tasks:
+			p.run(task)
+			if p.retire() {
+				return
+			}
+		case <-p.done:
+			p.drain()
+			return
+		}
+	}
+}
+
+func (p *WorkerPool) run(task func()) {
+	p.slots <- struct{}{}
+	defer func() {
+		<-p.slots
+	}()
+
+	task()
+}
+
+func (p *WorkerPool) retire() bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.workers-p.suspended <= p.size {
+		return false
+	}
+	p.workers--
+
+	return true
+}
+
+func (p *WorkerPool) drain() {
+	for {
+		select {
+		case task := <-p.tasks:
+			go task()
+		default:
+			return
+		}
+	}
+}
+
+func (p *WorkerPool) suspend() func() {
+	<-p.slots
+
+	p.mutex.Lock()
+	p.suspended++
+	spawn := p.workers-p.suspended < p.size && !p.isClosed()
+	if spawn {
+		p.workers++
+	}
+	p.mutex.Unlock()
+
+	if spawn {
+		go p.work(func() {})
+	}
+
+	return func() {
+		p.slots <- struct{}{}
+
+		p.mutex.Lock()
+		p.suspended--
+		p.mutex.Unlock()
+	}
+}
+
+var (
+	poolWorkers     = &sync.Map{}
+	poolWorkerCount int32
+)
+
+type poolWorker struct {
+	pool        *WorkerPool
+	isSuspended bool
+}
+
+// suspend releases the worker pool slot of the calling goroutine while it
+// waits, and returns the function which takes a slot again.
+func suspend() func() {
+	if atomic.LoadInt32(&poolWorkerCount) == 0 {
+		return resumeNothing
+	}
+
+	value, ok := poolWorkers.Load(goroutineID())
+	if !ok {
+		return resumeNothing
+	}
+
+	worker := value.(*poolWorker)
+	if worker.isSuspended {
+		return resumeNothing
+	}
+	worker.isSuspended = true
+	resume := worker.pool.suspend()
+
+	return func() {
+		resume()
+		worker.isSuspended = false
+	}
+}
+
+func resumeNothing() {}
+
+type executorHolder struct {
+	executor Executor
+}
+
+var globalExecutor atomic.Pointer[executorHolder]
+
+func init() {
+	globalExecutor.Store(&executorHolder{
+		executor: GoroutineExecutor,
+	})
+}
+
+func SetExecutor(executor Executor) func() {
+	previous := globalExecutor.Swap(&executorHolder{
+		executor: executor,
+	})
+
+	return func() {
+		globalExecutor.Store(previous)
+	}
+}
+
+func WithExecutor(executor Executor) Option {
+	return func(meta *metadata) {
+		meta.executor = executor
+	}
+}
+
+func isDefaultExecutor() bool {
+	return globalExecutor.Load().executor == GoroutineExecutor
+}
+
+func executorOf(promise Awaiter) Executor {
+	if upstream, ok := promise.(observable); ok {
+		return currentExecutor(upstream.metadata().executor)
+	}
+
+	return currentExecutor(nil)
+}
+
+func currentExecutor(executor Executor) Executor {
+	if executor != nil {
+		return executor
+	}
+
+	return globalExecutor.Load().executor
+}
@@ -0,0 +1,420 @@
+package go_promise
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type countingExecutor struct {
+	count int32
+}
+
+func (e *countingExecutor) Submit(task func()) {
+	atomic.AddInt32(&e.count, 1)
+	go task()
+}
+
+func TestInlineExecutor(t *testing.T) {
+	t.Run("it should resolve promises in order", func(t *testing.T) {
+		restore := SetExecutor(InlineExecutor)
+		defer restore()
+
+		order := []int{}
+		promises := make(Promises, 0, 5)
+		for i := 0; i < 5; i++ {
+			value := i
+			promises = append(promises, Function(func() (int, error) {
+				order = append(order, value)
+				return value, nil
+			}))
+		}
+
+		result, err := Await[[]int](All[int](promises))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(result, []int{0, 1, 2, 3, 4}) {
+			t.Error("result is not in order")
+		}
+		if !reflect.DeepEqual(order, []int{0, 1, 2, 3, 4}) {
+			t.Error("promises are not executed in order")
+		}
+	})
+
+	t.Run("it should support wrappers and resolvers", func(t *testing.T) {
+		restore := SetExecutor(InlineExecutor)
+		defer restore()
+
+		expected := errors.New("error")
+
+		value, err := Await[int](WithTimeout[int](Resolve(10), time.Minute))
+		if err != nil || value != 10 {
+			t.Error("timeout result is not 10")
+		}
+
+		value, err = Await[int](AsPreExecuted[int](Resolve(20)))
+		if err != nil || value != 20 {
+			t.Error("pre-executed result is not 20")
+		}
+
+		value, err = Await[int](Any[int](Promises{Reject(expected), Resolve(30)}))
+		if err != nil || value != 30 {
+			t.Error("any result is not 30")
+		}
+
+		_, err = Await[int](Race[int](Promises{Reject(expected), Resolve(40)}))
+		if err != expected {
+			t.Error("race error is not as expected")
+		}
+
+		value, err = Await[int](Resolve(25).With(Then(func(value int) (int, error) {
+			return value * 2, nil
+		})))
+		if err != nil || value != 50 {
+			t.Error("chained result is not 50")
+		}
+	})
+
+	t.Run("it should run channels, callbacks and streams synchronously", func(t *testing.T) {
+		restore := SetExecutor(InlineExecutor)
+		defer restore()
+
+		resultChan := ToChan[int](Resolve(10))
+		if len(resultChan) != 1 {
+			t.Error("channel result is not ready")
+		}
+
+		called := false
+		Callbackify[int](func() Promise {
+			return Resolve(10)
+		})(func(value int, err error) {
+			called = value == 10 && err == nil
+		})
+		if !called {
+			t.Error("callback is not called")
+		}
+
+		calls := 0
+		Throttle[int](func() (int, error) {
+			calls++
+			return 10, nil
+		}, time.Minute)()
+		if calls != 1 {
+			t.Error("throttled function is not called")
+		}
+
+		result, err := Await[[]int](Collect[int](Merge[int](context.Background(), FromSlice([]int{1, 2}), FromSlice([]int{3}))))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(result, []int{1, 3, 2}) {
+			t.Error("merged result is not in order")
+		}
+	})
+}
+
+func TestWorkerPool(t *testing.T) {
+	t.Run("it should not deadlock on nested promises", func(t *testing.T) {
+		pool := NewWorkerPool(2)
+		defer pool.Close()
+
+		restore := SetExecutor(pool)
+		defer restore()
+
+		promises := make(Promises, 0, 50)
+		for i := 0; i < 50; i++ {
+			promises = append(promises, WithTimeout[int](Resolve(i), time.Minute))
+		}
+
+		result, err := Await[[]int](All[int](promises))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if len(result) != 50 {
+			t.Error("result length is not 50")
+		}
+	})
+
+	t.Run("it should bound running tasks", func(t *testing.T) {
+		pool := NewWorkerPool(4)
+		defer pool.Close()
+
+		restore := SetExecutor(pool)
+		defer restore()
+
+		running := int32(0)
+		peak := int32(0)
+
+		promises := make(Promises, 0, 100)
+		for i := 0; i < 100; i++ {
+			promises = append(promises, Function(func() (int, error) {
+				count := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+
+				for {
+					current := atomic.LoadInt32(&peak)
+					if count <= current || atomic.CompareAndSwapInt32(&peak, current, count) {
+						break
+					}
+				}
+				time.Sleep(time.Millisecond)
+				return 0, nil
+			}))
+		}
+
+		_, err := Await[[]int](All[int](promises))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if atomic.LoadInt32(&peak) > 4 {
+			t.Error("running tasks are not bounded")
+		}
+	})
+
+	t.Run("it should queue tasks on busy pool", func(t *testing.T) {
+		pool := NewWorkerPool(1)
+		defer pool.Close()
+
+		release := make(chan struct{})
+		pool.Submit(func() {
+			<-release
+		})
+
+		start := time.Now()
+		ran := make(chan struct{})
+		pool.Submit(func() {
+			close(ran)
+		})
+		if time.Since(start) > 50*time.Millisecond {
+			t.Error("submit is blocked")
+		}
+
+		select {
+		case <-ran:
+			t.Error("task is not queued")
+		case <-time.After(20 * time.Millisecond):
+		}
+
+		close(release)
+		<-ran
+	})
+
+	t.Run("it should race on busy pool", func(t *testing.T) {
+		pool := NewWorkerPool(2)
+		defer pool.Close()
+
+		for i := 0; i < 2; i++ {
+			pool.Submit(func() {
+				time.Sleep(50 * time.Millisecond)
+			})
+		}
+
+		restore := SetExecutor(pool)
+		defer restore()
+
+		slow := New(func(resolve ResolveFunc[int], reject RejectFunc) {
+			time.Sleep(500 * time.Millisecond)
+			resolve(1)
+		})
+
+		start := time.Now()
+		value, err := Await[int](Race[int](Promises{slow, Resolve(2)}))
+		if err != nil || value != 2 {
+			t.Error("result is not 2")
+		}
+		if time.Since(start) > 300*time.Millisecond {
+			t.Error("race waits for slow promise")
+		}
+	})
+
+	t.Run("it should resolve any on busy pool", func(t *testing.T) {
+		pool := NewWorkerPool(2)
+		defer pool.Close()
+
+		for i := 0; i < 2; i++ {
+			pool.Submit(func() {
+				time.Sleep(50 * time.Millisecond)
+			})
+		}
+
+		restore := SetExecutor(pool)
+		defer restore()
+
+		slow := New(func(resolve ResolveFunc[int], reject RejectFunc) {
+			time.Sleep(500 * time.Millisecond)
+			resolve(1)
+		})
+
+		start := time.Now()
+		value, err := Await[int](Any[int](Promises{slow, Reject(errors.New("error")), Resolve(3)}))
+		if err != nil || value != 3 {
+			t.Error("result is not 3")
+		}
+		if time.Since(start) > 300*time.Millisecond {
+			t.Error("any waits for slow promise")
+		}
+	})
+
+	t.Run("it should not block constructors on busy pool", func(t *testing.T) {
+		pool := NewWorkerPool(1)
+		defer pool.Close()
+
+		release := make(chan struct{})
+		pool.Submit(func() {
+			<-release
+		})
+
+		start := time.Now()
+		promise := AsPreExecuted[int](New(func(resolve ResolveFunc[int], reject RejectFunc) {
+			time.Sleep(100 * time.Millisecond)
+			resolve(10)
+		}, WithExecutor(pool)))
+		if time.Since(start) > 50*time.Millisecond {
+			t.Error("constructor is blocked")
+		}
+
+		close(release)
+		value, err := Await[int](promise)
+		if err != nil || value != 10 {
+			t.Error("result is not 10")
+		}
+	})
+
+	t.Run("it should time out on busy pool", func(t *testing.T) {
+		pool := NewWorkerPool(1)
+		defer pool.Close()
+
+		release := make(chan struct{})
+		defer close(release)
+		pool.Submit(func() {
+			<-release
+		})
+
+		restore := SetExecutor(pool)
+		defer restore()
+
+		slow := New(func(resolve ResolveFunc[int], reject RejectFunc) {
+			time.Sleep(500 * time.Millisecond)
+			resolve(10)
+		})
+
+		start := time.Now()
+		_, err := Await[int](WithTimeout[int](slow, 20*time.Millisecond))
+		if err != TimeoutErr {
+			t.Error("timeout is expected")
+		}
+		if time.Since(start) > 200*time.Millisecond {
+			t.Error("timeout is late")
+		}
+	})
+
+	t.Run("it should resolve fallback on busy pool", func(t *testing.T) {
+		pool := NewWorkerPool(1)
+		defer pool.Close()
+
+		restore := SetExecutor(pool)
+		defer restore()
+
+		slow := New(func(resolve ResolveFunc[int], reject RejectFunc) {
+			time.Sleep(500 * time.Millisecond)
+			resolve(10)
+		})
+
+		start := time.Now()
+		value, err := Await[int](WithTimeoutFallback[int](slow, 20*time.Millisecond, 20))
+		if err != nil || value != 20 {
+			t.Error("result is not 20")
+		}
+		if time.Since(start) > 200*time.Millisecond {
+			t.Error("timeout is late")
+		}
+	})
+
+	t.Run("it should buffer streams on pool", func(t *testing.T) {
+		pool := NewWorkerPool(1)
+		defer pool.Close()
+
+		restore := SetExecutor(pool)
+		defer restore()
+
+		result, err := Await[[]int](Collect[int](Buffer[int](context.Background(), FromSlice([]int{1, 2, 3, 4}), 2)))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(result, []int{1, 2, 3, 4}) {
+			t.Error("result is not 1, 2, 3 and 4")
+		}
+	})
+
+	t.Run("it should run tasks after close", func(t *testing.T) {
+		pool := NewWorkerPool(1)
+		pool.Close()
+
+		value, err := Await[int](New(func(resolve ResolveFunc[int], reject RejectFunc) {
+			resolve(10)
+		}, WithExecutor(pool)))
+		if err != nil || value != 10 {
+			t.Error("result is not 10")
+		}
+	})
+}
+
+func TestWithExecutor(t *testing.T) {
+	t.Run("it should submit to promise executor", func(t *testing.T) {
+		executor := &countingExecutor{}
+
+		promise := Function(func() (int, error) {
+			return 10, nil
+		}, WithExecutor(executor))
+		value, _ := Await[int](promise.With(Then(func(value int) (int, error) {
+			return value, nil
+		})))
+
+		if value != 10 {
+			t.Error("result is not 10")
+		}
+		if atomic.LoadInt32(&executor.count) != 2 {
+			t.Error("executor is not used by promise and chained promise")
+		}
+	})
+
+	t.Run("it should restore global executor", func(t *testing.T) {
+		executor := &countingExecutor{}
+
+		restore := SetExecutor(executor)
+		_, _ = Await[int](Resolve(10))
+		restore()
+		_, _ = Await[int](Resolve(10))
+
+		if atomic.LoadInt32(&executor.count) != 1 {
+			t.Error("executor is not used once")
+		}
+	})
+}
+
+func TestSetExecutor(t *testing.T) {
+	t.Run("it should submit synchronous promises to global executor", func(t *testing.T) {
+		executor := &countingExecutor{}
+
+		restore := SetExecutor(executor)
+		defer restore()
+
+		_, _ = Await[int](Function(func() (int, error) {
+			return 10, nil
+		}))
+		_, _ = Reject(errors.New("error")).Await()
+		_, _ = Await[int](Resolve(10).With(Then(func(value int) (int, error) {
+			return value, nil
+		})).With(Catch(func(err error) int {
+			return 0
+		})))
+
+		if atomic.LoadInt32(&executor.count) != 5 {
+			t.Error("executor is not used by every promise")
+		}
+	})
+}
@@ -0,0 +1,108 @@
+package go_promise
+
+import (
+	"time"
+)
+
+// Factory creates a fresh promise on each call, so the work can be executed
+// again without resetting a promise which other awaiters may share.
+type Factory[V any] func() Promise
+
+func NewFactory[V any](executeFunc ExecuteFunc[V], options ...Option) Factory[V] {
+	return func() Promise {
+		return New(executeFunc, options...)
+	}
+}
+
+func FunctionFactory[V any](fn PromiseFunc[V], options ...Option) Factory[V] {
+	return func() Promise {
+		return Function(fn, options...)
+	}
+}
+
+func Retry[V any](factory Factory[V], maxRetries int) Promise {
+	return newObservedPromise(func(meta *metadata) ExecuteFunc[V] {
+		return func(resolve ResolveFunc[V], reject RejectFunc) {
+			first := factory()
+			meta.adopt(first)
+
+			value, err := retry[V](first, func() Promise {
+				next := factory()
+				meta.adopt(next)
+				return next
+			}, maxRetries, meta, 1, time.Now())
+			if err != nil {
+				reject(err)
+				return
+			}
+
+			resolve(value)
+		}
+	}, nil)
+}
+
+func Hedge[V any](factory Factory[V], delay time.Duration, maxAttempts int) Promise {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+
+	return newObservedPromise(func(meta *metadata) ExecuteFunc[V] {
+		return func(resolve ResolveFunc[V], reject RejectFunc) {
+			defer suspend()()
+
+			resultChan := make(settledResultChanel[V], maxAttempts)
+			startedAt := time.Now()
+			started, settled := 0, 0
+
+			start := func() {
+				started++
+				if started > 1 {
+					meta.emit(Event{
+						Kind:     EventRetry,
+						Duration: time.Since(startedAt),
+						Attempt:  started,
+					})
+				}
+
+				promise := factory()
+				meta.adopt(promise)
+				meta.spawn(func() {
+					sendSettledResultToChannel[V](promise, resultChan)
+				})
+			}
+			start()
+
+			errs := make(Errors, 0, maxAttempts)
+			for {
+				var hedge <-chan time.Time
+				if started < maxAttempts {
+					hedge = meta.clock.After(delay)
+				}
+
+				select {
+				case <-hedge:
+					start()
+				case result := <-resultChan:
+					settled++
+					result.observe()
+					if result.Error == nil {
+						resolve(result.Value)
+						for i := settled; i < started; i++ {
+							meta.spawn(resultChan.dropNext)
+						}
+						return
+					}
+
+					errs = append(errs, result.Error)
+					if settled == maxAttempts {
+						reject(errs)
+						return
+					}
+					if settled == started {
+						start()
+					}
+				}
+			}
+		}
+	}, nil)
+}
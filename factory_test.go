@@ -0,0 +1,186 @@
+package go_promise
+
+import (
+	"errors"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewFactory(t *testing.T) {
+	counter := 0
+	factory := NewFactory(func(resolve ResolveFunc[int], reject RejectFunc) {
+		counter++
+		resolve(counter)
+	})
+
+	first, _ := Await[int](factory())
+	second, _ := Await[int](factory())
+	if first != 1 || second != 2 {
+		t.Error("promises are not fresh")
+	}
+}
+
+func TestFunctionFactory(t *testing.T) {
+	factory := FunctionFactory(func() (int, error) {
+		return 10, nil
+	}, Named("fetch"))
+
+	promise := factory()
+	result, err := Await[int](promise)
+	if err != nil {
+		t.Error("error is not expected")
+	}
+	if result != 10 {
+		t.Error("result is not 10")
+	}
+	if nameOf(promise) != "fetch" {
+		t.Error("name is not fetch")
+	}
+}
+
+func TestRetry(t *testing.T) {
+	t.Run("it should resolve after failures", func(t *testing.T) {
+		tried := 0
+		recorder := NewRecorder()
+
+		promise := Retry(FunctionFactory(func() (int, error) {
+			if tried < 3 {
+				tried++
+				return 0, errors.New("error")
+			}
+			return 10, nil
+		}, WithHooks(recorder)), 3)
+
+		result, err := Await[int](promise)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+		if len(recorder.Filter(EventCreate)) != 4 {
+			t.Error("promises are not created for each attempt")
+		}
+	})
+
+	t.Run("it should reject after max retries", func(t *testing.T) {
+		tried := 0
+
+		result, err := Await[int](Retry(FunctionFactory(func() (int, error) {
+			tried++
+			return 0, errors.New("error")
+		}), 3))
+		if err != MaxRetriesErr {
+			t.Error("error is not as expected")
+		}
+		if result != 0 {
+			t.Error("result is not 0")
+		}
+		if tried != 4 {
+			t.Error("tried is not 4")
+		}
+	})
+
+	t.Run("it should not affect other awaiters", func(t *testing.T) {
+		expected := errors.New("error")
+		tried := 0
+
+		factory := FunctionFactory(func() (int, error) {
+			if tried < 1 {
+				tried++
+				return 0, expected
+			}
+			return 10, nil
+		})
+
+		shared := factory()
+		_, err := Await[int](shared)
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+
+		result, err := Await[int](Retry(factory, 3))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+
+		_, err = Await[int](shared)
+		if err != expected {
+			t.Error("shared promise is changed")
+		}
+	})
+}
+
+func TestHedge(t *testing.T) {
+	t.Run("it should not hedge fast promise", func(t *testing.T) {
+		var counter int32
+
+		result, err := Await[int](Hedge(FunctionFactory(func() (int, error) {
+			return int(atomic.AddInt32(&counter, 1)), nil
+		}), time.Minute, 3))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 1 || atomic.LoadInt32(&counter) != 1 {
+			t.Error("promise is hedged")
+		}
+	})
+
+	t.Run("it should resolve first finished attempt", func(t *testing.T) {
+		var counter int32
+		release := make(chan bool)
+		defer close(release)
+
+		result, err := Await[int](Hedge(FunctionFactory(func() (int, error) {
+			attempt := atomic.AddInt32(&counter, 1)
+			if attempt == 1 {
+				<-release
+			}
+			return int(attempt), nil
+		}), 10*time.Millisecond, 3))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 2 {
+			t.Error("result is not 2")
+		}
+	})
+
+	t.Run("it should start next attempt after failure", func(t *testing.T) {
+		var counter int32
+		start := time.Now()
+
+		result, err := Await[int](Hedge(FunctionFactory(func() (int, error) {
+			attempt := atomic.AddInt32(&counter, 1)
+			if attempt == 1 {
+				return 0, errors.New("error")
+			}
+			return int(attempt), nil
+		}), time.Minute, 3))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 2 {
+			t.Error("result is not 2")
+		}
+		if time.Since(start) > time.Second {
+			t.Error("next attempt is delayed")
+		}
+	})
+
+	t.Run("it should reject errors of all attempts", func(t *testing.T) {
+		expected := errors.New("error")
+
+		_, err := Await[int](Hedge(FunctionFactory(func() (int, error) {
+			return 0, expected
+		}), time.Millisecond, 3))
+		if !reflect.DeepEqual(err, Errors{expected, expected, expected}) {
+			t.Error("errors are not as expected")
+		}
+	})
+}
@@ -1,3 +1,3 @@
 module github.com/ompluscator/go-promise
 
-go 1.18
+go 1.21
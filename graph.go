@@ -0,0 +1,185 @@
+package go_promise
+
+import (
+	"errors"
+	"sync"
+)
+
+var (
+	DuplicateTaskErr     = errors.New("promise.duplicateTask")
+	MissingDependencyErr = errors.New("promise.missingDependency")
+	CyclicDependencyErr  = errors.New("promise.cyclicDependency")
+	SkippedTaskErr       = errors.New("promise.skippedTask")
+)
+
+type TaskFunc func(dependencies map[string]any) (any, error)
+
+type graphTask struct {
+	name         string
+	fn           TaskFunc
+	dependencies []string
+}
+
+type Graph struct {
+	tasks       []graphTask
+	concurrency int
+}
+
+func NewGraph() *Graph {
+	return &Graph{}
+}
+
+func (g *Graph) Task(name string, fn TaskFunc, dependencies ...string) *Graph {
+	g.tasks = append(g.tasks, graphTask{
+		name:         name,
+		fn:           fn,
+		dependencies: dependencies,
+	})
+
+	return g
+}
+
+func (g *Graph) Concurrency(limit int) *Graph {
+	g.concurrency = limit
+
+	return g
+}
+
+func (g *Graph) Run() Promise {
+	tasks := append([]graphTask{}, g.tasks...)
+	concurrency := g.concurrency
+
+	return newObservedPromise(func(meta *metadata) ExecuteFunc[map[string]any] {
+		return func(resolve ResolveFunc[map[string]any], reject RejectFunc) {
+			if err := validateGraph(tasks); err != nil {
+				reject(err)
+				return
+			}
+
+			var slots chan struct{}
+			if concurrency > 0 {
+				slots = make(chan struct{}, concurrency)
+			}
+
+			promises := make(map[string]Promise, len(tasks))
+			for _, task := range tasks {
+				promises[task.name] = newTaskPromise(task, promises, slots)
+			}
+
+			results := make([]SettledResult[any], len(tasks))
+			group := &sync.WaitGroup{}
+			group.Add(len(tasks))
+			for i, task := range tasks {
+				index, promise := i, promises[task.name]
+				meta.spawn(func() {
+					results[index].Value, results[index].Error = promise.Await()
+					group.Done()
+				})
+			}
+			resume := suspend()
+			group.Wait()
+			resume()
+
+			values := make(map[string]any, len(tasks))
+			errs := Errors{}
+			for i, task := range tasks {
+				if results[i].Error != nil {
+					errs = append(errs, results[i].Error)
+					continue
+				}
+				values[task.name] = results[i].Value
+			}
+
+			if len(errs) > 0 {
+				reject(errs)
+				return
+			}
+
+			resolve(values)
+		}
+	}, nil)
+}
+
+func newTaskPromise(task graphTask, promises map[string]Promise, slots chan struct{}) Promise {
+	return New(func(resolve ResolveFunc[any], reject RejectFunc) {
+		dependencies := make(map[string]any, len(task.dependencies))
+		for _, name := range task.dependencies {
+			value, err := promises[name].Await()
+			if err != nil {
+				reject(SkippedTaskErr)
+				return
+			}
+			dependencies[name] = value
+		}
+
+		if slots != nil {
+			resume := suspend()
+			slots <- struct{}{}
+			resume()
+			defer func() {
+				<-slots
+			}()
+		}
+
+		value, err := task.fn(dependencies)
+		if err != nil {
+			reject(err)
+			return
+		}
+
+		resolve(value)
+	}, Named(task.name))
+}
+
+func validateGraph(tasks []graphTask) error {
+	dependencies := make(map[string][]string, len(tasks))
+	for _, task := range tasks {
+		if _, ok := dependencies[task.name]; ok {
+			return withName(task.name, DuplicateTaskErr)
+		}
+		dependencies[task.name] = task.dependencies
+	}
+
+	for _, task := range tasks {
+		for _, name := range task.dependencies {
+			if _, ok := dependencies[name]; !ok {
+				return withName(task.name, MissingDependencyErr)
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	states := make(map[string]int, len(tasks))
+
+	var visit func(name string) bool
+	visit = func(name string) bool {
+		switch states[name] {
+		case visiting:
+			return false
+		case visited:
+			return true
+		}
+
+		states[name] = visiting
+		for _, dependency := range dependencies[name] {
+			if !visit(dependency) {
+				return false
+			}
+		}
+		states[name] = visited
+
+		return true
+	}
+
+	for _, task := range tasks {
+		if !visit(task.name) {
+			return withName(task.name, CyclicDependencyErr)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package go_promise
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGraph_Run(t *testing.T) {
+	t.Run("it should pass dependency values to tasks", func(t *testing.T) {
+		graph := NewGraph().
+			Task("a", func(map[string]any) (any, error) {
+				return 1, nil
+			}).
+			Task("b", func(map[string]any) (any, error) {
+				return 2, nil
+			}).
+			Task("sum", func(dependencies map[string]any) (any, error) {
+				return dependencies["a"].(int) + dependencies["b"].(int), nil
+			}, "a", "b")
+
+		result, err := Await[map[string]any](graph.Run())
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(result, map[string]any{"a": 1, "b": 2, "sum": 3}) {
+			t.Error("result is not as expected")
+		}
+	})
+
+	t.Run("it should run independent tasks concurrently", func(t *testing.T) {
+		graph := NewGraph()
+		for _, name := range []string{"a", "b", "c"} {
+			graph.Task(name, func(map[string]any) (any, error) {
+				time.Sleep(30 * time.Millisecond)
+				return nil, nil
+			})
+		}
+
+		start := time.Now()
+		_, err := Await[map[string]any](graph.Run())
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if time.Since(start) > 80*time.Millisecond {
+			t.Error("tasks are not concurrent")
+		}
+	})
+
+	t.Run("it should execute each task once", func(t *testing.T) {
+		var counter int32
+		graph := NewGraph().
+			Task("root", func(map[string]any) (any, error) {
+				return atomic.AddInt32(&counter, 1), nil
+			}).
+			Task("left", func(map[string]any) (any, error) {
+				return nil, nil
+			}, "root").
+			Task("right", func(map[string]any) (any, error) {
+				return nil, nil
+			}, "root")
+
+		_, _ = Await[map[string]any](graph.Run())
+		if atomic.LoadInt32(&counter) != 1 {
+			t.Error("counter is not 1")
+		}
+	})
+
+	t.Run("it should limit concurrency", func(t *testing.T) {
+		mutex := &sync.Mutex{}
+		active, peak := 0, 0
+
+		graph := NewGraph().Concurrency(2)
+		for _, name := range []string{"a", "b", "c", "d", "e"} {
+			graph.Task(name, func(map[string]any) (any, error) {
+				mutex.Lock()
+				active++
+				if active > peak {
+					peak = active
+				}
+				mutex.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				mutex.Lock()
+				active--
+				mutex.Unlock()
+				return nil, nil
+			})
+		}
+
+		_, err := Await[map[string]any](graph.Run())
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if peak > 2 {
+			t.Error("concurrency is not limited")
+		}
+	})
+
+	t.Run("it should skip dependents of failed task", func(t *testing.T) {
+		expected := errors.New("error")
+		called := false
+
+		graph := NewGraph().
+			Task("a", func(map[string]any) (any, error) {
+				return nil, expected
+			}).
+			Task("b", func(map[string]any) (any, error) {
+				called = true
+				return nil, nil
+			}, "a").
+			Task("c", func(map[string]any) (any, error) {
+				return 3, nil
+			})
+
+		_, err := Await[map[string]any](graph.Run())
+		errs, ok := err.(Errors)
+		if !ok || len(errs) != 2 {
+			t.Fatal("errors are not as expected")
+		}
+		if !errors.Is(errs[0], expected) {
+			t.Error("error is not as expected")
+		}
+		var named *NamedError
+		if !errors.As(errs[1], &named) || named.Name != "b" || named.Err != SkippedTaskErr {
+			t.Error("skipped task is not b")
+		}
+		if called {
+			t.Error("dependent task is called")
+		}
+	})
+
+	t.Run("it should reject missing dependency", func(t *testing.T) {
+		graph := NewGraph().Task("a", func(map[string]any) (any, error) {
+			return nil, nil
+		}, "b")
+
+		_, err := Await[map[string]any](graph.Run())
+		if !errors.Is(err, MissingDependencyErr) {
+			t.Error("missing dependency error is expected")
+		}
+	})
+
+	t.Run("it should reject duplicate task", func(t *testing.T) {
+		graph := NewGraph().
+			Task("a", func(map[string]any) (any, error) {
+				return nil, nil
+			}).
+			Task("a", func(map[string]any) (any, error) {
+				return nil, nil
+			})
+
+		_, err := Await[map[string]any](graph.Run())
+		if !errors.Is(err, DuplicateTaskErr) {
+			t.Error("duplicate task error is expected")
+		}
+	})
+
+	t.Run("it should reject cycle without running tasks", func(t *testing.T) {
+		called := false
+		task := func(map[string]any) (any, error) {
+			called = true
+			return nil, nil
+		}
+
+		graph := NewGraph().
+			Task("a", task, "c").
+			Task("b", task, "a").
+			Task("c", task, "b").
+			Task("d", task)
+
+		_, err := Await[map[string]any](graph.Run())
+		if !errors.Is(err, CyclicDependencyErr) {
+			t.Error("cyclic dependency error is expected")
+		}
+		if called {
+			t.Error("task is called")
+		}
+	})
+}
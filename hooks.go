@@ -0,0 +1,143 @@
+package go_promise
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+type EventKind int
+
+const (
+	EventCreate EventKind = iota
+	EventStart
+	EventResolve
+	EventReject
+	EventRetry
+	EventTimeout
+	EventCancel
+	EventDrop
+	EventUnhandled
+)
+
+func (k EventKind) String() string {
+	switch k {
+	case EventCreate:
+		return "create"
+	case EventStart:
+		return "start"
+	case EventResolve:
+		return "resolve"
+	case EventReject:
+		return "reject"
+	case EventRetry:
+		return "retry"
+	case EventTimeout:
+		return "timeout"
+	case EventCancel:
+		return "cancel"
+	case EventDrop:
+		return "drop"
+	case EventUnhandled:
+		return "unhandled"
+	default:
+		return "unknown"
+	}
+}
+
+type Event struct {
+	Kind       EventKind
+	ID         uint64
+	ParentID   uint64
+	Name       string
+	ParentName string
+	Time       time.Time
+	Duration   time.Duration
+	Attempt    int
+	Error      error
+}
+
+type Hook interface {
+	OnEvent(event Event)
+}
+
+type HookFunc func(event Event)
+
+func (f HookFunc) OnEvent(event Event) {
+	f(event)
+}
+
+var globalHooks = &hookRegistry{
+	mutex: &sync.RWMutex{},
+	hooks: map[uint64]Hook{},
+}
+
+func RegisterHook(hook Hook) func() {
+	return globalHooks.register(hook)
+}
+
+type hookRegistry struct {
+	mutex  *sync.RWMutex
+	hooks  map[uint64]Hook
+	lastID uint64
+	count  int32
+}
+
+func (r *hookRegistry) register(hook Hook) func() {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.lastID++
+	id := r.lastID
+	r.hooks[id] = hook
+	atomic.StoreInt32(&r.count, int32(len(r.hooks)))
+
+	once := &sync.Once{}
+	return func() {
+		once.Do(func() {
+			r.mutex.Lock()
+			defer r.mutex.Unlock()
+
+			delete(r.hooks, id)
+			atomic.StoreInt32(&r.count, int32(len(r.hooks)))
+		})
+	}
+}
+
+func (r *hookRegistry) isEmpty() bool {
+	return atomic.LoadInt32(&r.count) == 0
+}
+
+func (r *hookRegistry) dispatch(event Event) {
+	r.mutex.RLock()
+	hooks := make([]Hook, 0, len(r.hooks))
+	for _, hook := range r.hooks {
+		hooks = append(hooks, hook)
+	}
+	r.mutex.RUnlock()
+
+	for _, hook := range hooks {
+		hook.OnEvent(event)
+	}
+}
+
+func (m *metadata) emit(event Event) {
+	if len(m.hooks) == 0 && globalHooks.isEmpty() {
+		return
+	}
+
+	event.ID = m.id
+	event.Name = m.name
+	if m.parent != nil {
+		event.ParentID = m.parent.id
+		event.ParentName = m.parent.name
+	}
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	for _, hook := range m.hooks {
+		hook.OnEvent(event)
+	}
+	globalHooks.dispatch(event)
+}
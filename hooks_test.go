@@ -0,0 +1,166 @@
+package go_promise
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func eventKinds(events []Event) []EventKind {
+	kinds := make([]EventKind, 0, len(events))
+	for _, event := range events {
+		kinds = append(kinds, event.Kind)
+	}
+
+	return kinds
+}
+
+func equalKinds(actual []EventKind, expected ...EventKind) bool {
+	if len(actual) != len(expected) {
+		return false
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func TestWithHooks(t *testing.T) {
+	t.Run("it should emit resolve lifecycle", func(t *testing.T) {
+		recorder := NewRecorder()
+
+		promise := Function(func() (int, error) {
+			time.Sleep(10 * time.Millisecond)
+			return 10, nil
+		}, Named("fetch"), WithHooks(recorder))
+
+		_, _ = Await[int](promise)
+		_, _ = Await[int](promise)
+
+		events := recorder.Events()
+		if !equalKinds(eventKinds(events), EventCreate, EventStart, EventResolve) {
+			t.Fatalf("events are not as expected: %v", eventKinds(events))
+		}
+		for _, event := range events {
+			if event.Name != "fetch" {
+				t.Error("name is not fetch")
+			}
+		}
+		if events[2].Duration < 10*time.Millisecond {
+			t.Error("duration is not measured")
+		}
+	})
+
+	t.Run("it should emit reject lifecycle", func(t *testing.T) {
+		expected := errors.New("error")
+		recorder := NewRecorder()
+
+		_, _ = Await[int](Function(func() (int, error) {
+			return 0, expected
+		}, WithHooks(recorder)))
+
+		events := recorder.Events()
+		if !equalKinds(eventKinds(events), EventCreate, EventStart, EventReject) {
+			t.Fatalf("events are not as expected: %v", eventKinds(events))
+		}
+		if events[2].Error != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should link then to its upstream", func(t *testing.T) {
+		recorder := NewRecorder()
+
+		upstream := Function(func() (int, error) {
+			return 10, nil
+		}, Named("upstream"), WithHooks(recorder))
+		promise := upstream.With(Then(func(value int) (float64, error) {
+			return float64(value), nil
+		}))
+
+		_, _ = Await[float64](promise)
+
+		created := recorder.Filter(EventCreate)
+		if len(created) != 2 {
+			t.Fatal("both promises are not recorded")
+		}
+		if created[1].ParentID != created[0].ID {
+			t.Error("then is not linked to upstream")
+		}
+		if created[1].ParentName != "upstream" {
+			t.Error("parent name is not upstream")
+		}
+	})
+
+	t.Run("it should emit retries", func(t *testing.T) {
+		recorder := NewRecorder()
+		tried := 0
+
+		_, _ = Await[int](WithRetry[int](Function(func() (int, error) {
+			if tried < 2 {
+				tried++
+				return 0, errors.New("error")
+			}
+			return 10, nil
+		}, WithHooks(recorder)), 3))
+
+		retries := recorder.Filter(EventRetry)
+		if len(retries) != 2 {
+			t.Fatal("retries are not recorded")
+		}
+		if retries[0].Attempt != 2 || retries[1].Attempt != 3 {
+			t.Error("attempts are not as expected")
+		}
+	})
+
+	t.Run("it should emit timeout", func(t *testing.T) {
+		recorder := NewRecorder()
+		release := make(chan bool)
+		defer close(release)
+
+		_, _ = Await[int](WithTimeout[int](Function(func() (int, error) {
+			<-release
+			return 10, nil
+		}, WithHooks(recorder)), 10*time.Millisecond))
+
+		timeouts := recorder.Filter(EventTimeout)
+		if len(timeouts) != 1 {
+			t.Fatal("timeout is not recorded")
+		}
+		if timeouts[0].Duration != 10*time.Millisecond {
+			t.Error("duration is not as expected")
+		}
+	})
+
+	t.Run("it should emit cancel", func(t *testing.T) {
+		recorder := NewRecorder()
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, _ = Await[int](NewLimited(ctx, NewTokenBucket(1, 1), func(resolve ResolveFunc[int], reject RejectFunc) {
+			resolve(10)
+		}, WithHooks(recorder)))
+
+		if len(recorder.Filter(EventCancel)) != 1 {
+			t.Error("cancel is not recorded")
+		}
+	})
+}
+
+func TestRegisterHook(t *testing.T) {
+	recorder := NewRecorder()
+	unregister := RegisterHook(recorder)
+
+	_, _ = Await[int](Resolve(10))
+	unregister()
+	unregister()
+	_, _ = Await[int](Resolve(10))
+
+	if !equalKinds(eventKinds(recorder.Events()), EventCreate, EventStart, EventResolve) {
+		t.Errorf("events are not as expected: %v", eventKinds(recorder.Events()))
+	}
+}
@@ -0,0 +1,135 @@
+package go_promise
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
+var _ Limiter = &TokenBucket{}
+
+type TokenBucket struct {
+	mutex  *sync.Mutex
+	now    func() time.Time
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+func NewTokenBucket(rate float64, burst int) *TokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &TokenBucket{
+		mutex:  &sync.Mutex{},
+		now:    time.Now,
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+	}
+}
+
+func (b *TokenBucket) Allow() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refill()
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
+
+func (b *TokenBucket) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	delay := b.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		b.cancel()
+		return ctx.Err()
+	}
+}
+
+func (b *TokenBucket) reserve() time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refill()
+	b.tokens--
+	if b.tokens >= 0 {
+		return 0
+	}
+	if b.rate <= 0 {
+		return time.Duration(math.MaxInt64)
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+func (b *TokenBucket) cancel() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.refill()
+	b.tokens++
+	if b.tokens > b.burst {
+		b.tokens = b.burst
+	}
+}
+
+func (b *TokenBucket) refill() {
+	now := b.now()
+	if !b.last.IsZero() && b.rate > 0 {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+}
+
+func NewLimited[V any](ctx context.Context, limiter Limiter, executeFunc ExecuteFunc[V], options ...Option) Promise {
+	return newObservedPromise(func(meta *metadata) ExecuteFunc[V] {
+		return func(resolve ResolveFunc[V], reject RejectFunc) {
+			if err := limiter.Wait(ctx); err != nil {
+				if ctx.Err() != nil {
+					meta.emit(Event{
+						Kind:  EventCancel,
+						Error: err,
+					})
+				}
+				reject(err)
+				return
+			}
+
+			executeFunc(resolve, reject)
+		}
+	}, options)
+}
+
+func WithLimiter[V any](ctx context.Context, promise Awaiter, limiter Limiter) Promise {
+	return NewLimited(ctx, limiter, func(resolve ResolveFunc[V], reject RejectFunc) {
+		settle[V](promise, resolve, reject)
+	}, childOf(promise))
+}
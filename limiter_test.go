@@ -0,0 +1,193 @@
+package go_promise
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+type limiterMock struct {
+	calls int
+	err   error
+}
+
+func (l *limiterMock) Wait(ctx context.Context) error {
+	l.calls++
+	return l.err
+}
+
+func TestTokenBucket_Allow(t *testing.T) {
+	now := time.Now()
+	bucket := NewTokenBucket(1, 2)
+	bucket.now = func() time.Time {
+		return now
+	}
+
+	if !bucket.Allow() || !bucket.Allow() {
+		t.Error("burst is not allowed")
+	}
+	if bucket.Allow() {
+		t.Error("token is not expected")
+	}
+
+	now = now.Add(time.Second)
+	if !bucket.Allow() {
+		t.Error("token is not refilled")
+	}
+	if bucket.Allow() {
+		t.Error("token is not expected")
+	}
+}
+
+func TestTokenBucket_Wait(t *testing.T) {
+	t.Run("it should wait for the token", func(t *testing.T) {
+		bucket := NewTokenBucket(20, 1)
+
+		start := time.Now()
+		for i := 0; i < 3; i++ {
+			if err := bucket.Wait(context.Background()); err != nil {
+				t.Error("error is not expected")
+			}
+		}
+
+		if time.Since(start) < 90*time.Millisecond {
+			t.Error("tokens are not limited")
+		}
+	})
+
+	t.Run("it should respect cancellation", func(t *testing.T) {
+		bucket := NewTokenBucket(0.001, 1)
+		bucket.Allow()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		if err := bucket.Wait(ctx); err != context.DeadlineExceeded {
+			t.Error("deadline error is expected")
+		}
+		if bucket.tokens < 0 {
+			t.Error("canceled token is not returned")
+		}
+	})
+}
+
+func TestNewLimited(t *testing.T) {
+	t.Run("it should resolve after waiting", func(t *testing.T) {
+		limiter := &limiterMock{}
+
+		promise := NewLimited(context.Background(), limiter, func(resolve ResolveFunc[int], reject RejectFunc) {
+			resolve(10)
+		})
+		if limiter.calls != 0 {
+			t.Error("limiter is called before await")
+		}
+
+		result, err := Await[int](promise)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+		if limiter.calls != 1 {
+			t.Error("limiter is not called")
+		}
+	})
+
+	t.Run("it should reject without executing", func(t *testing.T) {
+		expected := errors.New("error")
+		executed := false
+
+		promise := NewLimited(context.Background(), &limiterMock{err: expected}, func(resolve ResolveFunc[int], reject RejectFunc) {
+			executed = true
+			resolve(10)
+		})
+
+		result, err := Await[int](promise)
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+		if result != 0 {
+			t.Error("result is not 0")
+		}
+		if executed {
+			t.Error("promise is executed")
+		}
+	})
+
+	t.Run("it should reject when context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		promise := NewLimited(ctx, NewTokenBucket(1, 1), func(resolve ResolveFunc[int], reject RejectFunc) {
+			resolve(10)
+		})
+
+		_, err := Await[int](promise)
+		if err != context.Canceled {
+			t.Error("canceled error is expected")
+		}
+	})
+}
+
+func TestWithLimiter(t *testing.T) {
+	t.Run("it should resolve int", func(t *testing.T) {
+		promise := WithLimiter[int](context.Background(), Function(func() (int, error) {
+			return 10, nil
+		}), NewTokenBucket(1, 1))
+
+		result, err := Await[int](promise)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+	})
+
+	t.Run("it should reject error", func(t *testing.T) {
+		expected := errors.New("error")
+
+		promise := WithLimiter[int](context.Background(), Function(func() (int, error) {
+			return 0, expected
+		}), NewTokenBucket(1, 1))
+
+		_, err := Await[int](promise)
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should reject with invalid type", func(t *testing.T) {
+		promise := WithLimiter[float64](context.Background(), Function(func() (int, error) {
+			return 10, nil
+		}), NewTokenBucket(1, 1))
+
+		_, err := Await[float64](promise)
+		if !errors.Is(err, InvalidTypeErr) {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should throttle promises in all", func(t *testing.T) {
+		bucket := NewTokenBucket(20, 1)
+
+		promises := make(Promises, 0, 3)
+		for i := 0; i < 3; i++ {
+			promises = append(promises, WithLimiter[int](context.Background(), Resolve(i), bucket))
+		}
+
+		start := time.Now()
+		result, err := Await[[]int](All[int](promises))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if len(result) != 3 {
+			t.Error("result does not have 3 values")
+		}
+		if time.Since(start) < 90*time.Millisecond {
+			t.Error("promises are not limited")
+		}
+	})
+}
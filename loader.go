@@ -0,0 +1,166 @@
+package go_promise
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var MissingKeyErr = errors.New("promise.missingKey")
+
+type BatchFunc[K comparable, V any] func(keys []K) (map[K]V, error)
+
+type LoaderSettings struct {
+	Wait         time.Duration
+	MaxBatchSize int
+	Clock        Clock
+}
+
+const defaultLoaderWait = time.Millisecond
+
+type Loader[K comparable, V any] struct {
+	mutex    *sync.Mutex
+	batchFn  BatchFunc[K, V]
+	settings LoaderSettings
+	cache    map[K]Promise
+	batch    *loaderBatch[K, V]
+}
+
+type loaderBatch[K comparable, V any] struct {
+	keys     []K
+	promises map[K]Promise
+	full     chan struct{}
+	done     chan struct{}
+	values   map[K]V
+	err      error
+}
+
+func NewLoader[K comparable, V any](batchFn BatchFunc[K, V], settings LoaderSettings) *Loader[K, V] {
+	if settings.Wait <= 0 {
+		settings.Wait = defaultLoaderWait
+	}
+	if settings.Clock == nil {
+		settings.Clock = SystemClock
+	}
+
+	return &Loader[K, V]{
+		mutex:    &sync.Mutex{},
+		batchFn:  batchFn,
+		settings: settings,
+		cache:    map[K]Promise{},
+	}
+}
+
+func (l *Loader[K, V]) Load(key K) Promise {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if promise, ok := l.cache[key]; ok {
+		return promise
+	}
+
+	batch := l.batch
+	if batch == nil {
+		batch = &loaderBatch[K, V]{
+			promises: map[K]Promise{},
+			full:     make(chan struct{}),
+			done:     make(chan struct{}),
+		}
+		l.batch = batch
+		go l.dispatch(batch)
+	}
+
+	promise, ok := batch.promises[key]
+	if !ok {
+		promise = New(func(resolve ResolveFunc[V], reject RejectFunc) {
+			resume := suspend()
+			<-batch.done
+			resume()
+			value, err := batch.result(key)
+			if err != nil {
+				reject(err)
+				return
+			}
+
+			resolve(value)
+		})
+		batch.keys = append(batch.keys, key)
+		batch.promises[key] = promise
+	}
+	l.cache[key] = promise
+
+	if l.settings.MaxBatchSize > 0 && len(batch.keys) >= l.settings.MaxBatchSize {
+		l.batch = nil
+		close(batch.full)
+	}
+
+	return promise
+}
+
+func (l *Loader[K, V]) LoadMany(keys []K) Promise {
+	ps := make(Promises, 0, len(keys))
+	for _, key := range keys {
+		ps = append(ps, l.Load(key))
+	}
+
+	return All[V](ps)
+}
+
+func (l *Loader[K, V]) Clear(key K) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	delete(l.cache, key)
+}
+
+func (l *Loader[K, V]) ClearAll() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.cache = map[K]Promise{}
+}
+
+func (l *Loader[K, V]) dispatch(batch *loaderBatch[K, V]) {
+	select {
+	case <-l.settings.Clock.After(l.settings.Wait):
+	case <-batch.full:
+	}
+
+	l.mutex.Lock()
+	if l.batch == batch {
+		l.batch = nil
+	}
+	l.mutex.Unlock()
+
+	currentExecutor(nil).Submit(func() {
+		l.run(batch)
+	})
+}
+
+func (l *Loader[K, V]) run(batch *loaderBatch[K, V]) {
+	batch.values, batch.err = l.batchFn(batch.keys)
+
+	l.mutex.Lock()
+	for _, key := range batch.keys {
+		if _, err := batch.result(key); err != nil && l.cache[key] == batch.promises[key] {
+			delete(l.cache, key)
+		}
+	}
+	l.mutex.Unlock()
+
+	close(batch.done)
+}
+
+func (b *loaderBatch[K, V]) result(key K) (V, error) {
+	var empty V
+	if b.err != nil {
+		return empty, b.err
+	}
+
+	value, ok := b.values[key]
+	if !ok {
+		return empty, MissingKeyErr
+	}
+
+	return value, nil
+}
@@ -0,0 +1,159 @@
+package go_promise
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+type batchRecorder struct {
+	mutex   *sync.Mutex
+	batches [][]int
+}
+
+func newBatchRecorder() *batchRecorder {
+	return &batchRecorder{
+		mutex: &sync.Mutex{},
+	}
+}
+
+func (r *batchRecorder) batchFunc(keys []int) (map[int]string, error) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	batch := append([]int{}, keys...)
+	sort.Ints(batch)
+	r.batches = append(r.batches, batch)
+
+	values := map[int]string{}
+	for _, key := range keys {
+		if key >= 0 {
+			values[key] = string(rune('a' + key))
+		}
+	}
+
+	return values, nil
+}
+
+func (r *batchRecorder) calls() [][]int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.batches
+}
+
+func TestLoader_Load(t *testing.T) {
+	t.Run("it should batch keys into one call", func(t *testing.T) {
+		recorder := newBatchRecorder()
+		loader := NewLoader[int, string](recorder.batchFunc, LoaderSettings{
+			Wait: 10 * time.Millisecond,
+		})
+
+		promises := Promises{loader.Load(0), loader.Load(1), loader.Load(2)}
+		result, err := Await[[]string](All[string](promises))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		sort.Strings(result)
+		if !reflect.DeepEqual(result, []string{"a", "b", "c"}) {
+			t.Error("result is not as expected")
+		}
+		if !reflect.DeepEqual(recorder.calls(), [][]int{{0, 1, 2}}) {
+			t.Error("keys are not batched")
+		}
+	})
+
+	t.Run("it should cache keys", func(t *testing.T) {
+		recorder := newBatchRecorder()
+		loader := NewLoader[int, string](recorder.batchFunc, LoaderSettings{})
+
+		first, _ := Await[string](loader.Load(1))
+		second, _ := Await[string](loader.Load(1))
+		if first != "b" || second != "b" {
+			t.Error("results are not b")
+		}
+		if len(recorder.calls()) != 1 {
+			t.Error("batch function is not called once")
+		}
+	})
+
+	t.Run("it should load again after clear", func(t *testing.T) {
+		recorder := newBatchRecorder()
+		loader := NewLoader[int, string](recorder.batchFunc, LoaderSettings{})
+
+		_, _ = Await[string](loader.Load(1))
+		loader.Clear(1)
+		_, _ = Await[string](loader.Load(1))
+		loader.ClearAll()
+		_, _ = Await[string](loader.Load(1))
+
+		if len(recorder.calls()) != 3 {
+			t.Error("batch function is not called three times")
+		}
+	})
+
+	t.Run("it should split batches by max size", func(t *testing.T) {
+		recorder := newBatchRecorder()
+		loader := NewLoader[int, string](recorder.batchFunc, LoaderSettings{
+			Wait:         time.Minute,
+			MaxBatchSize: 2,
+		})
+
+		result, err := Await[[]string](loader.LoadMany([]int{0, 1, 2, 3}))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if len(result) != 4 {
+			t.Error("result length is not 4")
+		}
+		calls := recorder.calls()
+		sort.Slice(calls, func(i, j int) bool {
+			return calls[i][0] < calls[j][0]
+		})
+		if !reflect.DeepEqual(calls, [][]int{{0, 1}, {2, 3}}) {
+			t.Error("batches are not split")
+		}
+	})
+
+	t.Run("it should reject missing keys", func(t *testing.T) {
+		recorder := newBatchRecorder()
+		loader := NewLoader[int, string](recorder.batchFunc, LoaderSettings{})
+
+		present := loader.Load(1)
+		missing := loader.Load(-1)
+
+		value, err := Await[string](present)
+		if err != nil || value != "b" {
+			t.Error("result is not b")
+		}
+		_, err = Await[string](missing)
+		if !errors.Is(err, MissingKeyErr) {
+			t.Error("missing key error is expected")
+		}
+	})
+
+	t.Run("it should reject all keys on batch error and not cache them", func(t *testing.T) {
+		expected := errors.New("error")
+		calls := 0
+		loader := NewLoader[int, string](func(keys []int) (map[int]string, error) {
+			calls++
+			if calls == 1 {
+				return nil, expected
+			}
+			return map[int]string{1: "b"}, nil
+		}, LoaderSettings{})
+
+		_, err := Await[[]string](loader.LoadMany([]int{1}))
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+
+		value, err := Await[string](loader.Load(1))
+		if err != nil || value != "b" {
+			t.Error("result is not b")
+		}
+	})
+}
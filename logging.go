@@ -0,0 +1,66 @@
+package go_promise
+
+import (
+	"context"
+	"log/slog"
+)
+
+var _ Hook = &LogHook{}
+
+type LogHook struct {
+	logger *slog.Logger
+}
+
+func NewLogHook(logger *slog.Logger) *LogHook {
+	return &LogHook{
+		logger: logger,
+	}
+}
+
+func WithLogger(logger *slog.Logger) Option {
+	return WithHooks(NewLogHook(logger))
+}
+
+func (h *LogHook) OnEvent(event Event) {
+	switch event.Kind {
+	case EventRetry:
+		h.log(slog.LevelInfo, "promise retry", event)
+	case EventTimeout:
+		h.log(slog.LevelWarn, "promise timeout", event)
+	case EventDrop:
+		h.log(slog.LevelDebug, "promise result dropped", event)
+	case EventUnhandled:
+		h.log(slog.LevelWarn, "promise unhandled rejection", event)
+	}
+}
+
+func (h *LogHook) log(level slog.Level, message string, event Event) {
+	ctx := context.Background()
+	if !h.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.Uint64("id", event.ID),
+	}
+	if event.Name != "" {
+		attrs = append(attrs, slog.String("name", event.Name))
+	}
+	if event.ParentID != 0 {
+		attrs = append(attrs, slog.Uint64("parent_id", event.ParentID))
+	}
+	if event.Attempt != 0 {
+		attrs = append(attrs, slog.Int("attempt", event.Attempt))
+	}
+	if event.Duration != 0 {
+		attrs = append(attrs, slog.Duration("duration", event.Duration))
+	}
+	if event.Error != nil {
+		attrs = append(attrs, slog.Any("error", event.Error))
+	}
+
+	h.logger.LogAttrs(ctx, level, message, slog.Attr{
+		Key:   "promise",
+		Value: slog.GroupValue(attrs...),
+	})
+}
@@ -0,0 +1,181 @@
+package go_promise
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+type logBuffer struct {
+	mutex  *sync.Mutex
+	buffer *bytes.Buffer
+}
+
+func newLogBuffer() *logBuffer {
+	return &logBuffer{
+		mutex:  &sync.Mutex{},
+		buffer: &bytes.Buffer{},
+	}
+}
+
+func (b *logBuffer) Write(p []byte) (int, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.buffer.Write(p)
+}
+
+func (b *logBuffer) records() []map[string]any {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	var records []map[string]any
+	for _, line := range strings.Split(strings.TrimSpace(b.buffer.String()), "\n") {
+		if line == "" {
+			continue
+		}
+
+		record := map[string]any{}
+		_ = json.Unmarshal([]byte(line), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+func (b *logBuffer) wait(count int) []map[string]any {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if records := b.records(); len(records) >= count {
+			return records
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	return b.records()
+}
+
+func newTestLogger(buffer *logBuffer) *slog.Logger {
+	return slog.New(slog.NewJSONHandler(buffer, &slog.HandlerOptions{
+		Level: slog.LevelDebug,
+	}))
+}
+
+func TestLogHook(t *testing.T) {
+	t.Run("it should log retries", func(t *testing.T) {
+		buffer := newLogBuffer()
+		tried := 0
+
+		_, _ = Await[int](WithRetry[int](Function(func() (int, error) {
+			if tried < 1 {
+				tried++
+				return 0, errors.New("error")
+			}
+			return 10, nil
+		}, Named("fetchUser"), WithLogger(newTestLogger(buffer))), 3))
+
+		records := buffer.records()
+		if len(records) != 1 {
+			t.Fatalf("records are not as expected: %v", records)
+		}
+		if records[0]["msg"] != "promise retry" || records[0]["level"] != "INFO" {
+			t.Error("record is not retry")
+		}
+
+		attrs := records[0]["promise"].(map[string]any)
+		if attrs["attempt"] != 2.0 {
+			t.Error("attempt is not 2")
+		}
+		if attrs["error"] != "fetchUser: error" {
+			t.Error("error is not logged")
+		}
+		if _, ok := attrs["duration"]; !ok {
+			t.Error("duration is not logged")
+		}
+	})
+
+	t.Run("it should log timeouts", func(t *testing.T) {
+		buffer := newLogBuffer()
+		release := make(chan bool)
+		defer close(release)
+
+		_, _ = Await[int](WithTimeout[int](Function(func() (int, error) {
+			<-release
+			return 10, nil
+		}, Named("fetchUser"), WithLogger(newTestLogger(buffer))), time.Millisecond))
+
+		records := buffer.records()
+		if len(records) != 1 {
+			t.Fatalf("records are not as expected: %v", records)
+		}
+		if records[0]["msg"] != "promise timeout" || records[0]["level"] != "WARN" {
+			t.Error("record is not timeout")
+		}
+
+		attrs := records[0]["promise"].(map[string]any)
+		if attrs["duration"] != float64(time.Millisecond) {
+			t.Error("duration is not logged")
+		}
+	})
+
+	t.Run("it should log dropped results", func(t *testing.T) {
+		stop := TrackUnhandledRejections(func(UnhandledRejection) {})
+		defer stop()
+
+		buffer := newLogBuffer()
+		logger := newTestLogger(buffer)
+		release := make(chan bool)
+
+		promise := Race[int](Promises{
+			Resolve(10),
+			Function(func() (int, error) {
+				<-release
+				return 0, errors.New("error")
+			}, Named("rejected"), WithLogger(logger)),
+			Function(func() (int, error) {
+				<-release
+				return 11, nil
+			}, Named("resolved"), WithLogger(logger)),
+		})
+
+		_, _ = Await[int](promise)
+		close(release)
+
+		records := buffer.wait(3)
+		if len(records) != 3 {
+			t.Fatalf("records are not as expected: %v", records)
+		}
+
+		messages := map[string]bool{}
+		for _, record := range records {
+			attrs := record["promise"].(map[string]any)
+			messages[attrs["name"].(string)+"/"+record["msg"].(string)] = true
+		}
+		if !messages["rejected/promise unhandled rejection"] {
+			t.Error("rejection is not logged")
+		}
+		if !messages["rejected/promise result dropped"] || !messages["resolved/promise result dropped"] {
+			t.Error("dropped result is not logged")
+		}
+	})
+
+	t.Run("it should not log without level", func(t *testing.T) {
+		buffer := newLogBuffer()
+		logger := slog.New(slog.NewJSONHandler(buffer, &slog.HandlerOptions{
+			Level: slog.LevelError,
+		}))
+
+		_, _ = Await[int](WithRetry[int](Function(func() (int, error) {
+			return 0, errors.New("error")
+		}, WithLogger(logger)), 3))
+
+		if len(buffer.records()) != 0 {
+			t.Error("records are not expected")
+		}
+	})
+}
@@ -1,35 +1,77 @@
 package go_promise
 
 import (
+	"context"
 	"errors"
 	"sync"
 	"time"
 )
 
-func New[V any](executeFunc ExecuteFunc[V]) Promise {
-	return &promise[V]{
-		mutex:       &sync.Mutex{},
+func New[V any](executeFunc ExecuteFunc[V], options ...Option) Promise {
+	return newPromise(executeFunc, options)
+}
+
+func newPromise[V any](executeFunc ExecuteFunc[V], options []Option) *promise[V] {
+	p := &promise[V]{
+		meta:        newMetadata(nil, options),
 		executeFunc: executeFunc,
 	}
+	p.meta.emit(Event{
+		Kind: EventCreate,
+	})
+
+	return p
 }
 
-func Reject(err error) Promise {
-	return New(func(_ ResolveFunc[any], reject RejectFunc) {
-		reject(err)
+// newSyncPromise creates a promise of a synchronous function, which runs in the
+// awaiting goroutine while the default executor is used.
+func newSyncPromise[V any](fn PromiseFunc[V], parent Awaiter, options []Option) *promise[V] {
+	p := &promise[V]{
+		meta:     newMetadata(parent, options),
+		syncFunc: fn,
+	}
+	p.meta.emit(Event{
+		Kind: EventCreate,
 	})
+
+	return p
+}
+
+func newSettledPromise[V any](value V, err error) *promise[V] {
+	p := newSyncPromise(func() (V, error) {
+		return value, err
+	}, nil, nil)
+	if isDefaultExecutor() {
+		p.value = value
+		p.err = err
+		p.isSettled = true
+	}
+
+	return p
+}
+
+func newObservedPromise[V any](build func(meta *metadata) ExecuteFunc[V], options []Option) *promise[V] {
+	p := newPromise[V](nil, options)
+	p.executeFunc = build(p.meta)
+
+	return p
+}
+
+func Reject(err error) Promise {
+	return newSettledPromise[any](nil, err)
 }
 
 func Resolve[V any](value V) Promise {
-	return New(func(resolve ResolveFunc[V], _ RejectFunc) {
-		resolve(value)
-	})
+	return newSettledPromise(value, nil)
 }
 
-type PromiseFunc[V any] func() (V, error)
+func From(awaiter Awaiter) Promise {
+	if promise, ok := awaiter.(Promise); ok {
+		return promise
+	}
 
-func Function[V any](fn PromiseFunc[V]) Promise {
-	return New(func(resolve ResolveFunc[V], reject RejectFunc) {
-		value, err := fn()
+	return New(func(resolve ResolveFunc[any], reject RejectFunc) {
+		value, err := awaiter.Await()
 		if err != nil {
 			reject(err)
 			return
@@ -39,100 +81,245 @@ func Function[V any](fn PromiseFunc[V]) Promise {
 	})
 }
 
-var TimeoutErr = errors.New("promise.timeout")
+type PromiseFunc[V any] func() (V, error)
 
-func WithTimeout[V any](promise Promise, duration time.Duration) Promise {
-	return New(func(resolve ResolveFunc[V], reject RejectFunc) {
-		resultChan := make(chan SettledResult[V])
-		group := &sync.WaitGroup{}
-		group.Add(1)
-		go func() {
-			group.Wait()
-			close(resultChan)
-		}()
-
-		go func() {
-			sendSettledResultToChannel[V](promise, resultChan)
-			group.Done()
-		}()
-
-		select {
-		case <-time.After(duration):
-			reject(TimeoutErr)
-		case result := <-resultChan:
-			if result.Error != nil {
-				reject(result.Error)
-			} else {
-				resolve(result.Value)
-			}
-		}
-	})
+func Function[V any](fn PromiseFunc[V], options ...Option) Promise {
+	return newSyncPromise(fn, nil, options)
 }
 
-var MaxRetriesErr = errors.New("promise.maxRetries")
+func (fn PromiseFunc[V]) execute(resolve ResolveFunc[V], reject RejectFunc) {
+	value, err := fn()
+	if err != nil {
+		reject(err)
+		return
+	}
 
-func WithRetry[V any](promise Promise, maxRetries int) Promise {
-	return Function(func() (V, error) {
-		return retry[V](promise, maxRetries)
-	})
+	resolve(value)
 }
 
-func AsPreExecuted[V any](promise Promise) Promise {
-	resultChan := make(settledResultChanel[V])
-	go func() {
-		sendSettledResultToChannel[V](promise, resultChan)
-	}()
+func NewDeferred[V any](options ...Option) (Promise, ResolveFunc[V], RejectFunc) {
+	done := make(chan struct{})
+	once := &sync.Once{}
+	var result SettledResult[V]
 
-	return New(func(resolve ResolveFunc[V], reject RejectFunc) {
-		result := <-resultChan
-		close(resultChan)
+	promise := New(func(resolve ResolveFunc[V], reject RejectFunc) {
+		resume := suspend()
+		<-done
+		resume()
 
 		if result.Error != nil {
 			reject(result.Error)
 		} else {
 			resolve(result.Value)
 		}
-	})
+	}, options...)
+
+	resolve := func(value V) {
+		once.Do(func() {
+			result.Value = value
+			close(done)
+		})
+	}
+
+	reject := func(err error) {
+		once.Do(func() {
+			result.Error = err
+			close(done)
+		})
+	}
+
+	return promise, resolve, reject
 }
 
-func sendSettledResultToChannel[V any](promise Promise, resultChan settledResultChanel[V]) {
-	value, err := promise.await()
-	if err != nil {
-		resultChan <- SettledResult[V]{
-			Error: err,
+var TimeoutErr = errors.New("promise.timeout")
+
+func WithTimeout[V any](promise Awaiter, duration time.Duration) Promise {
+	return newObservedPromise(func(meta *metadata) ExecuteFunc[V] {
+		return func(resolve ResolveFunc[V], reject RejectFunc) {
+			resultChan := make(settledResultChanel[V], 1)
+			meta.watch(func() {
+				sendSettledResultToChannel[V](promise, resultChan)
+			})
+
+			select {
+			case <-meta.clock.After(duration):
+				err := withName(nameOf(promise), TimeoutErr)
+				meta.emit(Event{
+					Kind:     EventTimeout,
+					Duration: duration,
+					Error:    err,
+				})
+				reject(err)
+				meta.spawn(resultChan.dropNext)
+			case result := <-resultChan:
+				result.observe()
+				if result.Error != nil {
+					reject(result.Error)
+				} else {
+					resolve(result.Value)
+				}
+			}
 		}
-		return
+	}, []Option{childOf(promise), asWatcher()})
+}
+
+type TimeoutOption func(settings *timeoutSettings)
+
+type timeoutSettings struct {
+	cancel    context.CancelFunc
+	isAbandon bool
+}
+
+func AbandonUpstream(cancel context.CancelFunc) TimeoutOption {
+	return func(settings *timeoutSettings) {
+		settings.cancel = cancel
+		settings.isAbandon = true
+	}
+}
+
+func WithTimeoutFallback[V any](promise Awaiter, duration time.Duration, fallback V, options ...TimeoutOption) Promise {
+	return withTimeoutFallback[V](promise, duration, func(resolve ResolveFunc[V], _ RejectFunc) {
+		resolve(fallback)
+	}, options)
+}
+
+func WithTimeoutFallbackPromise[V any](promise Awaiter, duration time.Duration, fallback Awaiter, options ...TimeoutOption) Promise {
+	return withTimeoutFallback[V](promise, duration, func(resolve ResolveFunc[V], reject RejectFunc) {
+		settle[V](fallback, resolve, reject)
+	}, options)
+}
+
+func withTimeoutFallback[V any](promise Awaiter, duration time.Duration, fallback ExecuteFunc[V], options []TimeoutOption) Promise {
+	settings := timeoutSettings{}
+	for _, option := range options {
+		option(&settings)
 	}
 
-	transformed, ok := value.(V)
-	if !ok {
-		resultChan <- SettledResult[V]{
-			Error: InvalidTypeErr,
+	return newObservedPromise(func(meta *metadata) ExecuteFunc[V] {
+		return func(resolve ResolveFunc[V], reject RejectFunc) {
+			resultChan := make(settledResultChanel[V], 1)
+			meta.watch(func() {
+				sendSettledResultToChannel[V](promise, resultChan)
+			})
+
+			select {
+			case <-meta.clock.After(duration):
+				meta.emit(Event{
+					Kind:     EventTimeout,
+					Duration: duration,
+					Error:    withName(nameOf(promise), TimeoutErr),
+				})
+				if settings.cancel != nil {
+					settings.cancel()
+				}
+				fallback(resolve, reject)
+
+				if settings.isAbandon {
+					meta.spawn(resultChan.discardNext)
+				} else {
+					meta.spawn(resultChan.dropNext)
+				}
+			case result := <-resultChan:
+				result.observe()
+				if result.Error != nil {
+					reject(result.Error)
+				} else {
+					resolve(result.Value)
+				}
+			}
 		}
-		return
+	}, []Option{childOf(promise), asWatcher()})
+}
+
+var MaxRetriesErr = errors.New("promise.maxRetries")
+
+// Deprecated: WithRetry resets the given promise before each new attempt, which
+// affects every other awaiter of the same promise. Use Retry with a Factory instead.
+func WithRetry[V any](promise Promise, maxRetries int) Promise {
+	return newObservedPromise(func(meta *metadata) ExecuteFunc[V] {
+		return func(resolve ResolveFunc[V], reject RejectFunc) {
+			value, err := retry[V](promise, func() Promise {
+				promise.Reset()
+				return promise
+			}, maxRetries, meta, 1, time.Now())
+			if err != nil {
+				reject(err)
+				return
+			}
+
+			resolve(value)
+		}
+	}, []Option{childOf(promise)})
+}
+
+func AsPreExecuted[V any](promise Awaiter) Promise {
+	resultChan := make(settledResultChanel[V], 1)
+	once := &sync.Once{}
+	var result settledResult[V]
+
+	p := newObservedPromise(func(meta *metadata) ExecuteFunc[V] {
+		return func(resolve ResolveFunc[V], reject RejectFunc) {
+			once.Do(func() {
+				resume := suspend()
+				result = <-resultChan
+				resume()
+			})
+			result.observe()
+
+			if result.Error != nil {
+				reject(result.Error)
+			} else {
+				resolve(result.Value)
+			}
+		}
+	}, []Option{childOf(promise)})
+
+	p.meta.spawn(func() {
+		sendSettledResultToChannel[V](promise, resultChan)
+	})
+
+	return p
+}
+
+func sendSettledResultToChannel[V any](promise Awaiter, resultChan settledResultChanel[V]) {
+	result := settledResult[V]{
+		source: promise,
 	}
 
-	resultChan <- SettledResult[V]{
-		Value: transformed,
+	value, err := awaitSilently(promise)
+	if err == nil {
+		result.Value, err = cast[V](promise, value)
 	}
+	result.Error = err
+
+	resultChan <- result
 }
 
-func retry[V any](promise Promise, maxRetries int) (V, error) {
+func retry[V any](promise Promise, renew func() Promise, maxRetries int, meta *metadata, attempt int, startedAt time.Time) (V, error) {
 	var empty V
 	if maxRetries < 0 {
-		return empty, MaxRetriesErr
+		return empty, withName(nameOf(promise), MaxRetriesErr)
 	}
 
-	value, err := promise.await()
+	value, err := promise.Await()
 	if err == nil {
-		transformed, ok := value.(V)
-		if !ok {
-			return retry[V](promise, maxRetries-1)
+		var transformed V
+		transformed, err = cast[V](promise, value)
+		if err == nil {
+			return transformed, nil
 		}
+	} else {
+		promise = renew()
+	}
 
-		return transformed, nil
+	if maxRetries > 0 {
+		meta.emit(Event{
+			Kind:     EventRetry,
+			Duration: time.Since(startedAt),
+			Attempt:  attempt + 1,
+			Error:    err,
+		})
 	}
-	promise.Reset()
 
-	return retry[V](promise, maxRetries-1)
+	return retry[V](promise, renew, maxRetries-1, meta, attempt+1, startedAt)
 }
@@ -1,11 +1,26 @@
 package go_promise
 
 import (
+	"context"
 	"errors"
+	"runtime"
+	"sync"
 	"testing"
 	"time"
 )
 
+func waitForGoroutines(count int) bool {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		if runtime.NumGoroutine() <= count {
+			return true
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	return false
+}
+
 func TestNew(t *testing.T) {
 	t.Run("it should resolve int", func(t *testing.T) {
 		value := New(func(resolveFunc ResolveFunc[int], rejectFunc RejectFunc) {
@@ -40,7 +55,7 @@ func TestNew(t *testing.T) {
 			resolveFunc(10)
 		})
 		result, err := Await[bool](value)
-		if err != InvalidTypeErr {
+		if !errors.Is(err, InvalidTypeErr) {
 			t.Error("error is not expected")
 		}
 		if result != false {
@@ -108,7 +123,7 @@ func TestFunction(t *testing.T) {
 			return 10, nil
 		})
 		result, err := Await[bool](value)
-		if err != InvalidTypeErr {
+		if !errors.Is(err, InvalidTypeErr) {
 			t.Error("error is not expected")
 		}
 		if result != false {
@@ -117,6 +132,85 @@ func TestFunction(t *testing.T) {
 	})
 }
 
+func TestNewDeferred(t *testing.T) {
+	t.Run("it should resolve from outside", func(t *testing.T) {
+		promise, resolve, _ := NewDeferred[int]()
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			resolve(10)
+		}()
+
+		result, err := Await[int](promise)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+	})
+
+	t.Run("it should reject from outside", func(t *testing.T) {
+		expected := errors.New("error")
+		promise, _, reject := NewDeferred[int]()
+
+		reject(expected)
+
+		result, err := Await[int](promise)
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+		if result != 0 {
+			t.Error("result is not 0")
+		}
+	})
+
+	t.Run("it should settle only once", func(t *testing.T) {
+		promise, resolve, reject := NewDeferred[int]()
+
+		group := &sync.WaitGroup{}
+		group.Add(20)
+		for i := 0; i < 10; i++ {
+			go func() {
+				resolve(10)
+				group.Done()
+			}()
+			go func() {
+				reject(errors.New("error"))
+				group.Done()
+			}()
+		}
+		group.Wait()
+
+		first, firstErr := Await[int](promise)
+		promise.Reset()
+		second, secondErr := Await[int](promise)
+		if first != second || firstErr != secondErr {
+			t.Error("promise is settled more than once")
+		}
+	})
+
+	t.Run("it should work with combinators", func(t *testing.T) {
+		first, resolveFirst, _ := NewDeferred[int]()
+		second, resolveSecond, _ := NewDeferred[int]()
+
+		promise := All[int](Promises{first, second}).With(Then(func(values []int) (int, error) {
+			return values[0] + values[1], nil
+		}))
+
+		resolveSecond(11)
+		resolveFirst(10)
+
+		result, err := Await[int](promise)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 21 {
+			t.Error("result is not 21")
+		}
+	})
+}
+
 func TestWithTimeout(t *testing.T) {
 	t.Run("it should resolve int", func(t *testing.T) {
 		value := WithTimeout[int](Function(func() (int, error) {
@@ -189,6 +283,256 @@ func TestWithTimeout(t *testing.T) {
 	})
 }
 
+func TestWithTimeout_leaks(t *testing.T) {
+	t.Run("it should not leave goroutines after timeout", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+		release := make(chan bool)
+
+		value := WithTimeout[int](Function(func() (int, error) {
+			<-release
+			return 10, nil
+		}), time.Millisecond)
+		_, err := Await[int](value)
+		if err != TimeoutErr {
+			t.Error("timeout is expected")
+		}
+
+		close(release)
+		if !waitForGoroutines(before) {
+			t.Error("goroutines are left behind")
+		}
+	})
+
+	t.Run("it should report rejection after timeout", func(t *testing.T) {
+		expected := errors.New("error")
+		rejections := make(chan UnhandledRejection, 10)
+		stop := TrackUnhandledRejections(func(rejection UnhandledRejection) {
+			rejections <- rejection
+		})
+		defer stop()
+
+		release := make(chan bool)
+		_, _ = Await[int](WithTimeout[int](Function(func() (int, error) {
+			<-release
+			return 0, expected
+		}), time.Millisecond))
+		close(release)
+
+		rejection, ok := waitForRejection(rejections)
+		if !ok {
+			t.Fatal("rejection is not reported")
+		}
+		if rejection.Error != expected {
+			t.Error("rejection is not as expected")
+		}
+	})
+
+	t.Run("it should execute again after reset", func(t *testing.T) {
+		counter := 0
+		inner := Function(func() (int, error) {
+			counter++
+			return counter, nil
+		})
+
+		value := WithTimeout[int](inner, time.Minute)
+		first, _ := Await[int](value)
+		inner.Reset()
+		value.Reset()
+		second, _ := Await[int](value)
+		if first != 1 || second != 2 {
+			t.Error("results are not 1 and 2")
+		}
+	})
+}
+
+func TestWithTimeoutFallback(t *testing.T) {
+	t.Run("it should resolve int", func(t *testing.T) {
+		value := WithTimeoutFallback[int](Function(func() (int, error) {
+			return 10, nil
+		}), time.Minute, 20)
+		result, err := Await[int](value)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+	})
+
+	t.Run("it should reject error without fallback", func(t *testing.T) {
+		expected := errors.New("error")
+
+		value := WithTimeoutFallback[int](Function(func() (int, error) {
+			return 0, expected
+		}), time.Minute, 20)
+		result, err := Await[int](value)
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+		if result != 0 {
+			t.Error("result is not 0")
+		}
+	})
+
+	t.Run("it should resolve fallback on timeout", func(t *testing.T) {
+		release := make(chan bool)
+		defer close(release)
+
+		value := WithTimeoutFallback[int](Function(func() (int, error) {
+			<-release
+			return 10, nil
+		}), 10*time.Millisecond, 20)
+		result, err := Await[int](value)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 20 {
+			t.Error("result is not 20")
+		}
+	})
+
+	t.Run("it should keep running the original promise", func(t *testing.T) {
+		counter := 0
+		release := make(chan bool)
+
+		original := Function(func() (int, error) {
+			<-release
+			counter++
+			return 10, nil
+		})
+
+		result, err := Await[int](WithTimeoutFallback[int](original, 10*time.Millisecond, 20))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 20 {
+			t.Error("result is not 20")
+		}
+
+		close(release)
+
+		result, err = Await[int](original)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+		if counter != 1 {
+			t.Error("counter is not 1")
+		}
+	})
+
+	t.Run("it should abandon the original promise", func(t *testing.T) {
+		rejections := make(chan UnhandledRejection, 10)
+		stop := TrackUnhandledRejections(func(rejection UnhandledRejection) {
+			rejections <- rejection
+		})
+		defer stop()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		canceled := make(chan error, 1)
+
+		original := Function(func() (int, error) {
+			<-ctx.Done()
+			canceled <- ctx.Err()
+			return 0, ctx.Err()
+		})
+
+		result, err := Await[int](WithTimeoutFallback[int](original, 10*time.Millisecond, 20, AbandonUpstream(cancel)))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 20 {
+			t.Error("result is not 20")
+		}
+
+		select {
+		case err := <-canceled:
+			if err != context.Canceled {
+				t.Error("original promise is not canceled")
+			}
+		case <-time.After(time.Second):
+			t.Fatal("original promise is not canceled")
+		}
+
+		if _, ok := waitForRejection(rejections); ok {
+			t.Error("rejection is not expected")
+		}
+	})
+}
+
+func TestWithTimeoutFallbackPromise(t *testing.T) {
+	t.Run("it should resolve fallback promise on timeout", func(t *testing.T) {
+		release := make(chan bool)
+		defer close(release)
+
+		value := WithTimeoutFallbackPromise[int](Function(func() (int, error) {
+			<-release
+			return 10, nil
+		}), 10*time.Millisecond, Function(func() (int, error) {
+			return 20, nil
+		}))
+		result, err := Await[int](value)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 20 {
+			t.Error("result is not 20")
+		}
+	})
+
+	t.Run("it should reject fallback error on timeout", func(t *testing.T) {
+		expected := errors.New("error")
+		release := make(chan bool)
+		defer close(release)
+
+		value := WithTimeoutFallbackPromise[int](Function(func() (int, error) {
+			<-release
+			return 10, nil
+		}), 10*time.Millisecond, Reject(expected))
+		_, err := Await[int](value)
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should not execute fallback promise", func(t *testing.T) {
+		executed := false
+
+		value := WithTimeoutFallbackPromise[int](Function(func() (int, error) {
+			return 10, nil
+		}), time.Minute, Function(func() (int, error) {
+			executed = true
+			return 20, nil
+		}))
+		result, err := Await[int](value)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+		if executed {
+			t.Error("fallback is executed")
+		}
+	})
+
+	t.Run("it should reject with invalid type from fallback", func(t *testing.T) {
+		release := make(chan bool)
+		defer close(release)
+
+		value := WithTimeoutFallbackPromise[int](Function(func() (int, error) {
+			<-release
+			return 10, nil
+		}), 10*time.Millisecond, Resolve(20.0))
+		_, err := Await[int](value)
+		if !errors.Is(err, InvalidTypeErr) {
+			t.Error("error is not as expected")
+		}
+	})
+}
+
 func TestWithRetry(t *testing.T) {
 	t.Run("it should resolve int", func(t *testing.T) {
 		value := WithRetry[int](Function(func() (int, error) {
@@ -276,7 +620,7 @@ func TestAsPreExecuted(t *testing.T) {
 		}))
 
 		result, err := Await[float64](promise)
-		if err != InvalidTypeErr {
+		if !errors.Is(err, InvalidTypeErr) {
 			t.Error("error is not as expected")
 		}
 		if result != 0 {
@@ -346,3 +690,70 @@ func TestAsPreExecuted(t *testing.T) {
 		}
 	})
 }
+
+func TestFrom(t *testing.T) {
+	promise := Resolve(10)
+	if From(promise) != promise {
+		t.Error("promise is wrapped")
+	}
+}
+
+func TestAsPreExecuted_leaks(t *testing.T) {
+	t.Run("it should not leave goroutines without await", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+		release := make(chan bool)
+
+		AsPreExecuted[int](Function(func() (int, error) {
+			<-release
+			return 10, nil
+		}))
+
+		close(release)
+		if !waitForGoroutines(before) {
+			t.Error("goroutines are left behind")
+		}
+	})
+
+	t.Run("it should resolve the same value after reset", func(t *testing.T) {
+		counter := 0
+
+		value := AsPreExecuted[int](Function(func() (int, error) {
+			counter++
+			return 10, nil
+		}))
+
+		first, err := Await[int](value)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		value.Reset()
+		second, err := Await[int](value)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+
+		if first != 10 || second != 10 {
+			t.Error("results are not 10")
+		}
+		if counter != 1 {
+			t.Error("counter is not 1")
+		}
+	})
+
+	t.Run("it should pre-execute custom awaiter once", func(t *testing.T) {
+		future := &futureMock{value: 10}
+
+		value := AsPreExecuted[int](future)
+		for i := 0; i < 3; i++ {
+			result, _ := Await[int](value)
+			if result != 10 {
+				t.Error("result is not 10")
+			}
+			value.Reset()
+		}
+
+		if future.calls != 1 {
+			t.Error("custom awaiter is not awaited once")
+		}
+	})
+}
@@ -0,0 +1,172 @@
+package go_promise
+
+import (
+	"sync"
+)
+
+var _ Hook = &Recorder{}
+
+type Recorder struct {
+	mutex  *sync.Mutex
+	events []Event
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		mutex: &sync.Mutex{},
+	}
+}
+
+func (r *Recorder) OnEvent(event Event) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.events = append(r.events, event)
+}
+
+func (r *Recorder) Events() []Event {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	events := make([]Event, len(r.events))
+	copy(events, r.events)
+
+	return events
+}
+
+func (r *Recorder) Filter(kind EventKind) []Event {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	events := make([]Event, 0, len(r.events))
+	for _, event := range r.events {
+		if event.Kind == kind {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+type Counter interface {
+	Inc()
+}
+
+type Observer interface {
+	Observe(value float64)
+}
+
+var _ Hook = MetricsHook{}
+
+type MetricsHook struct {
+	Counter  func(event string, name string) Counter
+	Duration func(event string, name string) Observer
+}
+
+func (h MetricsHook) OnEvent(event Event) {
+	kind := event.Kind.String()
+	if h.Counter != nil {
+		h.Counter(kind, event.Name).Inc()
+	}
+
+	if h.Duration == nil {
+		return
+	}
+	switch event.Kind {
+	case EventResolve, EventReject, EventTimeout:
+		h.Duration(kind, event.Name).Observe(event.Duration.Seconds())
+	}
+}
+
+type Span interface {
+	End(err error)
+}
+
+type Tracer interface {
+	Start(parent Span, name string) Span
+}
+
+var _ Hook = &TracingHook{}
+
+type TracingHook struct {
+	mutex   *sync.Mutex
+	tracer  Tracer
+	spans   map[uint64]Span
+	pending map[uint64][]Event
+	waiting map[uint64]uint64
+}
+
+func NewTracingHook(tracer Tracer) *TracingHook {
+	return &TracingHook{
+		mutex:   &sync.Mutex{},
+		tracer:  tracer,
+		spans:   map[uint64]Span{},
+		pending: map[uint64][]Event{},
+		waiting: map[uint64]uint64{},
+	}
+}
+
+func (h *TracingHook) OnEvent(event Event) {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+
+	switch event.Kind {
+	case EventStart:
+		if event.ParentID == 0 {
+			h.start(nil, event)
+			return
+		}
+
+		parent, ok := h.spans[event.ParentID]
+		if !ok {
+			h.pending[event.ParentID] = append(h.pending[event.ParentID], event)
+			h.waiting[event.ID] = event.ParentID
+			return
+		}
+
+		h.start(parent, event)
+	case EventResolve, EventReject:
+		if parentID, ok := h.waiting[event.ID]; ok {
+			h.unqueue(parentID, event.ID)
+			h.start(nil, event)
+		}
+
+		span, ok := h.spans[event.ID]
+		if !ok {
+			return
+		}
+
+		span.End(event.Error)
+		delete(h.spans, event.ID)
+	}
+}
+
+func (h *TracingHook) start(parent Span, event Event) {
+	if _, ok := h.spans[event.ID]; ok {
+		return
+	}
+
+	span := h.tracer.Start(parent, event.Name)
+	h.spans[event.ID] = span
+
+	for _, child := range h.pending[event.ID] {
+		delete(h.waiting, child.ID)
+		h.start(span, child)
+	}
+	delete(h.pending, event.ID)
+}
+
+func (h *TracingHook) unqueue(parentID uint64, id uint64) {
+	delete(h.waiting, id)
+
+	children := h.pending[parentID]
+	for i, child := range children {
+		if child.ID == id {
+			h.pending[parentID] = append(children[:i], children[i+1:]...)
+			break
+		}
+	}
+	if len(h.pending[parentID]) == 0 {
+		delete(h.pending, parentID)
+	}
+}
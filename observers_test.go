@@ -0,0 +1,177 @@
+package go_promise
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+type counterMock struct {
+	mutex *sync.Mutex
+	calls map[string]int
+	label string
+}
+
+func (c *counterMock) Inc() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.calls[c.label]++
+}
+
+func (c *counterMock) Observe(value float64) {
+	c.Inc()
+}
+
+type spanMock struct {
+	name   string
+	parent *spanMock
+	ended  bool
+	err    error
+}
+
+func (s *spanMock) End(err error) {
+	s.ended = true
+	s.err = err
+}
+
+type tracerMock struct {
+	spans []*spanMock
+}
+
+func (t *tracerMock) Start(parent Span, name string) Span {
+	span := &spanMock{
+		name: name,
+	}
+	if parent != nil {
+		span.parent = parent.(*spanMock)
+	}
+	t.spans = append(t.spans, span)
+
+	return span
+}
+
+func (t *tracerMock) find(name string) *spanMock {
+	for _, span := range t.spans {
+		if span.name == name {
+			return span
+		}
+	}
+
+	return nil
+}
+
+func TestRecorder(t *testing.T) {
+	recorder := NewRecorder()
+	recorder.OnEvent(Event{Kind: EventCreate})
+	recorder.OnEvent(Event{Kind: EventStart})
+
+	events := recorder.Events()
+	events[0].Kind = EventReject
+
+	if recorder.Events()[0].Kind != EventCreate {
+		t.Error("events are not copied")
+	}
+	if len(recorder.Filter(EventStart)) != 1 {
+		t.Error("start event is not filtered")
+	}
+}
+
+func TestMetricsHook(t *testing.T) {
+	counters := &counterMock{
+		mutex: &sync.Mutex{},
+		calls: map[string]int{},
+	}
+	durations := &counterMock{
+		mutex: &sync.Mutex{},
+		calls: map[string]int{},
+	}
+
+	hook := MetricsHook{
+		Counter: func(event string, name string) Counter {
+			counters.label = event + "/" + name
+			return counters
+		},
+		Duration: func(event string, name string) Observer {
+			durations.label = event + "/" + name
+			return durations
+		},
+	}
+
+	_, _ = Await[int](Function(func() (int, error) {
+		return 10, nil
+	}, Named("fetch"), WithHooks(hook)))
+	_, _ = Await[int](Function(func() (int, error) {
+		return 0, errors.New("error")
+	}, Named("fetch"), WithHooks(hook)))
+
+	if counters.calls["create/fetch"] != 2 {
+		t.Error("create is not counted")
+	}
+	if counters.calls["resolve/fetch"] != 1 || counters.calls["reject/fetch"] != 1 {
+		t.Error("settlement is not counted")
+	}
+	if durations.calls["resolve/fetch"] != 1 || durations.calls["reject/fetch"] != 1 {
+		t.Error("duration is not observed")
+	}
+	if durations.calls["start/fetch"] != 0 {
+		t.Error("start duration is not expected")
+	}
+}
+
+func TestTracingHook(t *testing.T) {
+	t.Run("it should create child span for then", func(t *testing.T) {
+		tracer := &tracerMock{}
+		hook := NewTracingHook(tracer)
+
+		promise := Function(func() (int, error) {
+			return 10, nil
+		}, Named("upstream"), WithHooks(hook)).With(Then(func(value int) (float64, error) {
+			return 0, errors.New("error")
+		}))
+
+		_, _ = Await[float64](promise)
+
+		upstream := tracer.find("upstream")
+		then := tracer.find("")
+		if upstream == nil || then == nil {
+			t.Fatal("spans are not started")
+		}
+		if then.parent != upstream {
+			t.Error("then span is not a child of upstream")
+		}
+		if !upstream.ended || !then.ended {
+			t.Error("spans are not ended")
+		}
+		if then.err == nil {
+			t.Error("then span error is expected")
+		}
+		if len(hook.spans) != 0 || len(hook.pending) != 0 || len(hook.waiting) != 0 {
+			t.Error("hook state is not cleaned")
+		}
+	})
+
+	t.Run("it should end child of already settled upstream", func(t *testing.T) {
+		tracer := &tracerMock{}
+		hook := NewTracingHook(tracer)
+
+		upstream := Resolve(10)
+		_, _ = Await[int](upstream)
+
+		_, _ = Await[float64](upstream.With(Then(func(value int) (float64, error) {
+			return float64(value), nil
+		})).With(func(promise Promise) Promise {
+			return New(func(resolve ResolveFunc[float64], reject RejectFunc) {
+				settle[float64](promise, resolve, reject)
+			}, Named("tail"), childOf(promise), WithHooks(hook))
+		}))
+
+		tail := tracer.find("tail")
+		if tail == nil || !tail.ended {
+			t.Fatal("tail span is not ended")
+		}
+		if len(hook.spans) != 0 || len(hook.pending) != 0 || len(hook.waiting) != 0 {
+			t.Error("hook state is not cleaned")
+		}
+	})
+}
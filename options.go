@@ -0,0 +1,148 @@
+package go_promise
+
+import (
+	"sync/atomic"
+)
+
+type Option func(meta *metadata)
+
+func Named(name string) Option {
+	return func(meta *metadata) {
+		meta.name = name
+	}
+}
+
+func WithHooks(hooks ...Hook) Option {
+	return func(meta *metadata) {
+		meta.hooks = append(meta.hooks, hooks...)
+	}
+}
+
+func childOf(parent Awaiter) Option {
+	return func(meta *metadata) {
+		meta.inherit(parent)
+	}
+}
+
+func dependsOn[P Awaiter](ps []P) Option {
+	return func(meta *metadata) {
+		for _, p := range ps {
+			if upstream, ok := any(p).(observable); ok {
+				meta.upstream = append(meta.upstream, upstream.metadata())
+			}
+		}
+	}
+}
+
+// asWatcher runs the promise on its own goroutine, so a busy executor cannot
+// delay it.
+func asWatcher() Option {
+	return func(meta *metadata) {
+		meta.isWatcher = true
+	}
+}
+
+type observable interface {
+	metadata() *metadata
+}
+
+var lastID uint64
+
+type metadata struct {
+	id        uint64
+	name      string
+	parent    *metadata
+	hooks     []Hook
+	clock     Clock
+	executor  Executor
+	isWatcher bool
+	upstream  []*metadata
+	scope     atomic.Pointer[Scope]
+	stack     string
+}
+
+func newMetadata(parent Awaiter, options []Option) *metadata {
+	meta := &metadata{
+		id:    atomic.AddUint64(&lastID, 1),
+		clock: SystemClock,
+	}
+	if parent != nil {
+		meta.inherit(parent)
+	}
+	for _, option := range options {
+		option(meta)
+	}
+	if pendingPromises.isWatching() {
+		meta.stack = creationStack()
+	}
+
+	return meta
+}
+
+func (m *metadata) execute(task func()) {
+	if m.isWatcher {
+		m.watch(task)
+		return
+	}
+
+	m.spawn(task)
+}
+
+func (m *metadata) spawn(task func()) {
+	m.spawnOn(currentExecutor(m.executor), task)
+}
+
+func (m *metadata) watch(task func()) {
+	m.spawnOn(GoroutineExecutor, task)
+}
+
+func (m *metadata) spawnOn(executor Executor, task func()) {
+	if awaitGraph.isDetecting() {
+		task = awaitGraph.inherit(task)
+	}
+
+	scope := m.scope.Load()
+	if scope == nil {
+		executor.Submit(task)
+		return
+	}
+
+	scope.spawn(executor, task)
+}
+
+func (m *metadata) adopt(promise Awaiter) {
+	scope := m.scope.Load()
+	if scope == nil {
+		return
+	}
+
+	if child, ok := promise.(observable); ok {
+		child.metadata().attach(scope)
+	}
+}
+
+func (m *metadata) inherit(parent Awaiter) {
+	upstream, ok := parent.(observable)
+	if !ok {
+		return
+	}
+
+	parentMeta := upstream.metadata()
+	m.parent = parentMeta
+	m.hooks = append(m.hooks, parentMeta.hooks...)
+	m.clock = parentMeta.clock
+	m.executor = parentMeta.executor
+}
+
+func (m *metadata) attach(scope *Scope) {
+	if !m.scope.CompareAndSwap(nil, scope) {
+		return
+	}
+
+	if m.parent != nil {
+		m.parent.attach(scope)
+	}
+	for _, upstream := range m.upstream {
+		upstream.attach(scope)
+	}
+}
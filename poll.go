@@ -0,0 +1,82 @@
+package go_promise
+
+import (
+	"context"
+	"time"
+)
+
+type PollFunc[V any] func() (V, bool, error)
+
+type PollOptions struct {
+	Context     context.Context
+	Clock       Clock
+	MaxDuration time.Duration
+	Backoff     float64
+	MaxInterval time.Duration
+}
+
+func Poll[V any](fn PollFunc[V], interval time.Duration, options PollOptions) Promise {
+	promiseOptions := []Option{}
+	if options.Clock != nil {
+		promiseOptions = append(promiseOptions, WithClock(options.Clock))
+	}
+
+	return newObservedPromise(func(meta *metadata) ExecuteFunc[V] {
+		return func(resolve ResolveFunc[V], reject RejectFunc) {
+			ctx := options.Context
+			if ctx == nil {
+				ctx = context.Background()
+			}
+
+			var deadline <-chan time.Time
+			if options.MaxDuration > 0 {
+				deadline = meta.clock.After(options.MaxDuration)
+			}
+
+			wait := interval
+			for {
+				value, done, err := fn()
+				if err != nil {
+					reject(err)
+					return
+				}
+				if done {
+					resolve(value)
+					return
+				}
+
+				select {
+				case <-meta.clock.After(wait):
+				case <-deadline:
+					meta.emit(Event{
+						Kind:     EventTimeout,
+						Duration: options.MaxDuration,
+						Error:    TimeoutErr,
+					})
+					reject(TimeoutErr)
+					return
+				case <-ctx.Done():
+					meta.emit(Event{
+						Kind:  EventCancel,
+						Error: ctx.Err(),
+					})
+					reject(ctx.Err())
+					return
+				}
+
+				wait = nextInterval(wait, options)
+			}
+		}
+	}, promiseOptions)
+}
+
+func nextInterval(wait time.Duration, options PollOptions) time.Duration {
+	if options.Backoff > 1 {
+		wait = time.Duration(float64(wait) * options.Backoff)
+	}
+	if options.MaxInterval > 0 && wait > options.MaxInterval {
+		wait = options.MaxInterval
+	}
+
+	return wait
+}
@@ -0,0 +1,114 @@
+package go_promise
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type clockMock struct {
+	now       time.Time
+	durations []time.Duration
+}
+
+func (c *clockMock) Now() time.Time {
+	return c.now
+}
+
+func (c *clockMock) After(duration time.Duration) <-chan time.Time {
+	c.durations = append(c.durations, duration)
+	c.now = c.now.Add(duration)
+
+	channel := make(chan time.Time, 1)
+	channel <- c.now
+	return channel
+}
+
+func TestPoll(t *testing.T) {
+	t.Run("it should resolve when done", func(t *testing.T) {
+		polled := 0
+
+		promise := Poll(func() (int, bool, error) {
+			polled++
+			return polled * 10, polled == 3, nil
+		}, time.Millisecond, PollOptions{})
+
+		result, err := Await[int](promise)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 30 {
+			t.Error("result is not 30")
+		}
+	})
+
+	t.Run("it should reject error", func(t *testing.T) {
+		expected := errors.New("error")
+
+		promise := Poll(func() (int, bool, error) {
+			return 0, false, expected
+		}, time.Millisecond, PollOptions{})
+
+		_, err := Await[int](promise)
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should reject with timeout", func(t *testing.T) {
+		promise := Poll(func() (int, bool, error) {
+			return 0, false, nil
+		}, time.Millisecond, PollOptions{
+			MaxDuration: 20 * time.Millisecond,
+		})
+
+		_, err := Await[int](promise)
+		if err != TimeoutErr {
+			t.Error("timeout is expected")
+		}
+	})
+
+	t.Run("it should reject when context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+
+		promise := Poll(func() (int, bool, error) {
+			cancel()
+			return 0, false, nil
+		}, time.Minute, PollOptions{
+			Context: ctx,
+		})
+
+		_, err := Await[int](promise)
+		if err != context.Canceled {
+			t.Error("canceled error is expected")
+		}
+	})
+
+	t.Run("it should back off between polls", func(t *testing.T) {
+		clock := &clockMock{
+			now: time.Now(),
+		}
+		polled := 0
+
+		promise := Poll(func() (int, bool, error) {
+			polled++
+			return 10, polled == 5, nil
+		}, time.Second, PollOptions{
+			Clock:       clock,
+			Backoff:     2,
+			MaxInterval: 5 * time.Second,
+		})
+
+		_, err := Await[int](promise)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+
+		expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}
+		if !reflect.DeepEqual(clock.durations, expected) {
+			t.Errorf("durations are not as expected: %v", clock.durations)
+		}
+	})
+}
@@ -1,89 +1,210 @@
 package go_promise
 
 import (
-	"errors"
+	"runtime"
 	"sync"
+	"sync/atomic"
+	"time"
 )
 
+// Awaiter is the contract which every combinator in this package accepts,
+// so any type implementing it can be awaited like a promise.
+type Awaiter interface {
+	Await() (any, error)
+}
+
 type Promise interface {
+	Awaiter
 	With(chainFunc ChainFunc) Promise
+	// Reset discards the settled result, so the next await executes the promise
+	// again. It waits for an execution in progress to finish first. Awaiters
+	// which already received the result keep it, and any await after Reset
+	// receives the result of the new execution. To execute the same work again
+	// without affecting other awaiters, use a Factory instead.
 	Reset()
-	await() (any, error)
 }
 
 var _ Promise = &promise[int]{}
 
 type promise[V any] struct {
-	mutex       *sync.Mutex
+	mutex       sync.Mutex
+	meta        *metadata
 	executeFunc ExecuteFunc[V]
+	syncFunc    PromiseFunc[V]
+	isSettled   bool
 	value       V
 	err         error
 	isDone      bool
+	isHandled   atomic.Bool
+	isTracked   bool
 }
 
 func (p *promise[V]) With(chainFunc ChainFunc) Promise {
 	return chainFunc(p)
 }
 
+func (p *promise[V]) metadata() *metadata {
+	return p.meta
+}
+
 func (p *promise[V]) Reset() {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
 
 	p.isDone = false
+	p.isSettled = false
+	p.isHandled.Store(false)
 	p.err = nil
 
 	var empty V
 	p.value = empty
 }
 
-func (p *promise[V]) await() (any, error) {
-	p.mutex.Lock()
+func (p *promise[V]) Await() (any, error) {
+	value, err := p.awaitSilently()
+	p.markHandled()
+
+	return value, err
+}
+
+func (p *promise[V]) markHandled() {
+	if !p.isHandled.Load() {
+		p.isHandled.Store(true)
+	}
+}
+
+func (p *promise[V]) awaitSilently() (any, error) {
+	if awaitGraph.isDetecting() {
+		release, err := awaitGraph.enter(p.meta)
+		if err != nil {
+			var empty V
+			return empty, err
+		}
+		defer release()
+	}
+
+	if !p.mutex.TryLock() {
+		resume := suspend()
+		p.mutex.Lock()
+		resume()
+	}
 	defer p.mutex.Unlock()
 
 	if p.isDone {
 		return p.value, p.err
 	}
 
-	valueChan := make(chan V)
-	errChan := make(chan error)
+	startedAt := time.Now()
+	p.meta.emit(Event{
+		Kind: EventStart,
+		Time: startedAt,
+	})
 
-	go func() {
-		p.executeFunc(createResolveMethod[V](valueChan), createRejectMethod(errChan))
-	}()
+	finish := func() {}
+	if pendingPromises.isWatching() {
+		finish = pendingPromises.start(p.meta, startedAt)
+	}
 
 	var value V
 	var err error
-	select {
-	case err = <-errChan:
-		break
-	case value = <-valueChan:
-		break
+	if p.syncFunc != nil && p.meta.executor == nil && isDefaultExecutor() {
+		if p.isSettled {
+			value, err = p.value, p.err
+			p.isSettled = false
+		} else {
+			value, err = p.executeSync()
+		}
+	} else {
+		value, err = p.execute()
 	}
+	finish()
 
-	close(errChan)
-	close(valueChan)
-
+	err = withName(p.meta.name, err)
 	p.value = value
 	p.err = err
 	p.isDone = true
 
+	if err != nil && !p.isTracked && unhandledRejections.isTracking() {
+		p.isTracked = true
+		runtime.SetFinalizer(p, func(p *promise[V]) {
+			if p.err != nil && !p.isHandled.Load() {
+				unhandledRejections.report(p.meta, p.err)
+			}
+		})
+	}
+
+	kind := EventResolve
+	if err != nil {
+		kind = EventReject
+	}
+	p.meta.emit(Event{
+		Kind:     kind,
+		Duration: time.Since(startedAt),
+		Error:    err,
+	})
+
 	return value, err
 }
 
-var InvalidTypeErr = errors.New("promise.invalidType")
+func (p *promise[V]) execute() (V, error) {
+	s := newSettlement[V]()
+
+	executeFunc := p.executeFunc
+	if executeFunc == nil {
+		executeFunc = p.syncFunc.execute
+	}
+
+	p.meta.execute(func() {
+		if awaitGraph.isDetecting() {
+			defer awaitGraph.run(p.meta)()
+		}
+		executeFunc(s.resolve, s.reject)
+	})
+
+	resume := suspend()
+	s.done.Wait()
+	resume()
 
-func Await[V any](promise Promise) (V, error) {
+	return s.value, s.err
+}
+
+func (p *promise[V]) executeSync() (V, error) {
+	if awaitGraph.isDetecting() {
+		defer awaitGraph.run(p.meta)()
+	}
+
+	value, err := p.syncFunc()
+	if err != nil {
+		var empty V
+		return empty, err
+	}
+
+	return value, nil
+}
+
+func Await[V any](promise Awaiter) (V, error) {
 	var empty V
 
-	result, err := promise.await()
+	result, err := promise.Await()
 	if err != nil {
 		return empty, err
 	}
 
-	transformed, ok := result.(V)
-	if !ok {
-		return empty, InvalidTypeErr
+	return cast[V](promise, result)
+}
+
+func settle[V any](promise Awaiter, resolve ResolveFunc[V], reject RejectFunc) {
+	value, err := promise.Await()
+	if err != nil {
+		reject(err)
+		return
+	}
+
+	transformed, err := cast[V](promise, value)
+	if err != nil {
+		reject(err)
+		return
 	}
 
-	return transformed, nil
+	resolve(transformed)
 }
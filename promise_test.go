@@ -2,9 +2,22 @@ package go_promise
 
 import (
 	"errors"
+	"sort"
 	"testing"
+	"time"
 )
 
+type futureMock struct {
+	value any
+	err   error
+	calls int
+}
+
+func (f *futureMock) Await() (any, error) {
+	f.calls++
+	return f.value, f.err
+}
+
 func TestPromise_With(t *testing.T) {
 	t.Run("it should wrap a promise with then clause", func(t *testing.T) {
 		promise := Function(func() (int, error) {
@@ -86,3 +99,77 @@ func TestPromise_Reset(t *testing.T) {
 		}
 	})
 }
+
+func TestAwaiter(t *testing.T) {
+	t.Run("it should await custom type", func(t *testing.T) {
+		result, err := Await[int](&futureMock{value: 10})
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+	})
+
+	t.Run("it should combine custom types", func(t *testing.T) {
+		result, err := Await[[]int](All[int](Awaiters{
+			&futureMock{value: 10},
+			Resolve(11),
+		}))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+
+		sort.Ints(result)
+		if len(result) != 2 || result[0] != 10 || result[1] != 11 {
+			t.Error("result is not a slice of 10 and 11")
+		}
+	})
+
+	t.Run("it should race custom types", func(t *testing.T) {
+		expected := errors.New("error")
+
+		_, err := Await[int](Race[int](Awaiters{
+			&futureMock{err: expected},
+		}))
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should wrap custom type with timeout", func(t *testing.T) {
+		result, err := Await[int](WithTimeout[int](&futureMock{value: 10}, time.Minute))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+	})
+
+	t.Run("it should chain custom type", func(t *testing.T) {
+		future := &futureMock{value: 10}
+
+		promise := From(future).With(Then(func(value int) (float64, error) {
+			return float64(value), nil
+		}))
+
+		result, err := Await[float64](promise)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10.0 {
+			t.Error("result is not 10")
+		}
+		if future.calls != 1 {
+			t.Error("custom type is not awaited once")
+		}
+	})
+
+	t.Run("it should reject invalid type of custom type", func(t *testing.T) {
+		_, err := Await[bool](From(&futureMock{value: 10}))
+		if !errors.Is(err, InvalidTypeErr) {
+			t.Error("error is not as expected")
+		}
+	})
+}
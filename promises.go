@@ -1,114 +1,123 @@
 package go_promise
 
 import (
-	"sync"
+	"sync/atomic"
 )
 
 type Promises []Promise
 
-func AllSettled[V any](ps Promises) Promise {
-	return New(func(resolve ResolveFunc[SettledResults[V]], reject RejectFunc) {
-		resultChan := runRoutines[V](ps)
+// Awaiters accepts custom Awaiter implementations in the combinators, next to
+// promises created by this package.
+type Awaiters []Awaiter
 
-		values := make(SettledResults[V], 0, len(ps))
-		for result := range resultChan {
-			values = append(values, result)
-		}
-
-		resolve(values)
-	})
-}
+func AllSettled[V any, P Awaiter](ps []P) Promise {
+	return newObservedPromise(func(meta *metadata) ExecuteFunc[SettledResults[V]] {
+		return func(resolve ResolveFunc[SettledResults[V]], reject RejectFunc) {
+			defer suspend()()
 
-func All[V any](ps Promises) Promise {
-	return New(func(resolve ResolveFunc[[]V], reject RejectFunc) {
-		resultChan := runRoutines[V](ps)
+			resultChan := runRoutines[V](meta, ps)
 
-		values := make([]V, 0, len(ps))
-		for result := range resultChan {
-			if result.Error != nil {
-				reject(result.Error)
-				go resultChan.empty()
-				return
+			values := make(SettledResults[V], 0, len(ps))
+			for result := range resultChan {
+				result.observe()
+				values = append(values, result.SettledResult)
 			}
 
-			values = append(values, result.Value)
+			resolve(values)
 		}
-
-		resolve(values)
-	})
+	}, []Option{dependsOn(ps)})
 }
 
-func Any[V any](ps Promises) Promise {
-	return New(func(resolve ResolveFunc[V], reject RejectFunc) {
-		resultChan := runRoutines[V](ps)
-
-		errs := make(Errors, 0, len(ps))
-		for result := range resultChan {
-			if result.Error != nil {
-				errs = append(errs, result.Error)
-				continue
-			}
+func All[V any, P Awaiter](ps []P) Promise {
+	return newObservedPromise(func(meta *metadata) ExecuteFunc[[]V] {
+		return func(resolve ResolveFunc[[]V], reject RejectFunc) {
+			defer suspend()()
 
-			resolve(result.Value)
-			go resultChan.empty()
-			return
-		}
+			resultChan := runRoutines[V](meta, ps)
 
-		reject(errs)
-	})
-}
+			values := make([]V, 0, len(ps))
+			for result := range resultChan {
+				result.observe()
+				if result.Error != nil {
+					reject(result.Error)
+					meta.spawn(resultChan.discardAll)
+					return
+				}
 
-func Race[V any](ps Promises) Promise {
-	return New(func(resolve ResolveFunc[V], reject RejectFunc) {
-		resultChan := runRoutines[V](ps)
+				values = append(values, result.Value)
+			}
 
-		result := <-resultChan
-		if result.Error != nil {
-			reject(result.Error)
-		} else {
-			resolve(result.Value)
+			resolve(values)
 		}
-
-		go resultChan.empty()
-	})
+	}, []Option{dependsOn(ps)})
 }
 
-func runRoutines[V any](ps Promises) settledResultChanel[V] {
-	resultChan := make(settledResultChanel[V])
-	group := &sync.WaitGroup{}
-	group.Add(len(ps))
+func Any[V any, P Awaiter](ps []P) Promise {
+	return newObservedPromise(func(meta *metadata) ExecuteFunc[V] {
+		return func(resolve ResolveFunc[V], reject RejectFunc) {
+			defer suspend()()
 
-	for _, promise := range ps {
-		go func(p Promise) {
-			value, err := p.await()
-			if err != nil {
-				resultChan <- SettledResult[V]{
-					Error: err,
-				}
-				group.Done()
-				return
-			}
+			resultChan := runRoutines[V](meta, ps)
 
-			transformed, ok := value.(V)
-			if !ok {
-				resultChan <- SettledResult[V]{
-					Error: InvalidTypeErr,
+			errs := make(Errors, 0, len(ps))
+			for result := range resultChan {
+				result.observe()
+				if result.Error != nil {
+					errs = append(errs, result.Error)
+					continue
 				}
-				group.Done()
+
+				resolve(result.Value)
+				meta.spawn(func() {
+					resultChan.empty()
+				})
 				return
 			}
 
-			resultChan <- SettledResult[V]{
-				Value: transformed,
+			reject(errs)
+		}
+	}, []Option{dependsOn(ps)})
+}
+
+func Race[V any, P Awaiter](ps []P) Promise {
+	return newObservedPromise(func(meta *metadata) ExecuteFunc[V] {
+		return func(resolve ResolveFunc[V], reject RejectFunc) {
+			defer suspend()()
+
+			resultChan := runRoutines[V](meta, ps)
+
+			result := <-resultChan
+			result.observe()
+			if result.Error != nil {
+				reject(result.Error)
+			} else {
+				resolve(result.Value)
 			}
-			group.Done()
-		}(promise)
-	}
 
-	go func() {
-		group.Wait()
+			meta.spawn(func() {
+				resultChan.empty()
+			})
+		}
+	}, []Option{dependsOn(ps)})
+}
+
+func runRoutines[V any, P Awaiter](meta *metadata, ps []P) settledResultChanel[V] {
+	resultChan := make(settledResultChanel[V], len(ps))
+	if len(ps) == 0 {
 		close(resultChan)
-	}()
+		return resultChan
+	}
+
+	pending := int32(len(ps))
+	for _, promise := range ps {
+		p := promise
+		meta.spawn(func() {
+			sendSettledResultToChannel[V](p, resultChan)
+			if atomic.AddInt32(&pending, -1) == 0 {
+				close(resultChan)
+			}
+		})
+	}
 
 	return resultChan
 }
@@ -118,7 +118,7 @@ func TestAllSettled(t *testing.T) {
 			return strings.Compare(errs[i].Error(), errs[j].Error()) < 0
 		})
 
-		if !reflect.DeepEqual(errs, Errors{errors.New("error"), errors.New("error"), InvalidTypeErr}) {
+		if !reflect.DeepEqual(errs, Errors{errors.New("error"), errors.New("error"), &TypeError{Expected: "int", Actual: "float64"}}) {
 			t.Error("list of errors does not match")
 		}
 	})
@@ -172,6 +172,20 @@ func TestAll(t *testing.T) {
 			t.Error("result is not empty slice")
 		}
 	})
+
+	t.Run("it should accept slice of promises", func(t *testing.T) {
+		var promises Promises = []Promise{Resolve(10), Resolve(11)}
+
+		result, err := Await[[]int](All[int](promises))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+
+		sort.Ints(result)
+		if !reflect.DeepEqual(result, []int{10, 11}) {
+			t.Error("result is not a slice of 10 and 11")
+		}
+	})
 }
 
 func TestAny(t *testing.T) {
@@ -0,0 +1,129 @@
+package go_promise
+
+import (
+	"context"
+	"sync"
+)
+
+func Promisify[V any](fn func(callback func(V, error))) Factory[V] {
+	return func() Promise {
+		return fromCallback(fn)
+	}
+}
+
+func Promisify1[A any, V any](fn func(a A, callback func(V, error))) func(a A) Promise {
+	return func(a A) Promise {
+		return fromCallback(func(callback func(V, error)) {
+			fn(a, callback)
+		})
+	}
+}
+
+func Promisify2[A any, B any, V any](fn func(a A, b B, callback func(V, error))) func(a A, b B) Promise {
+	return func(a A, b B) Promise {
+		return fromCallback(func(callback func(V, error)) {
+			fn(a, b, callback)
+		})
+	}
+}
+
+func Promisify3[A any, B any, C any, V any](fn func(a A, b B, c C, callback func(V, error))) func(a A, b B, c C) Promise {
+	return func(a A, b B, c C) Promise {
+		return fromCallback(func(callback func(V, error)) {
+			fn(a, b, c, callback)
+		})
+	}
+}
+
+func PromisifyContext[V any](fn func(ctx context.Context) (V, error)) func(ctx context.Context) Promise {
+	return func(ctx context.Context) Promise {
+		return Function(func() (V, error) {
+			return fn(ctx)
+		})
+	}
+}
+
+func PromisifyContext1[A any, V any](fn func(ctx context.Context, a A) (V, error)) func(ctx context.Context, a A) Promise {
+	return func(ctx context.Context, a A) Promise {
+		return Function(func() (V, error) {
+			return fn(ctx, a)
+		})
+	}
+}
+
+func PromisifyContext2[A any, B any, V any](fn func(ctx context.Context, a A, b B) (V, error)) func(ctx context.Context, a A, b B) Promise {
+	return func(ctx context.Context, a A, b B) Promise {
+		return Function(func() (V, error) {
+			return fn(ctx, a, b)
+		})
+	}
+}
+
+func PromisifyContext3[A any, B any, C any, V any](fn func(ctx context.Context, a A, b B, c C) (V, error)) func(ctx context.Context, a A, b B, c C) Promise {
+	return func(ctx context.Context, a A, b B, c C) Promise {
+		return Function(func() (V, error) {
+			return fn(ctx, a, b, c)
+		})
+	}
+}
+
+func Callbackify[V any](factory Factory[V]) func(callback func(V, error)) {
+	return func(callback func(V, error)) {
+		toCallback[V](factory, callback)
+	}
+}
+
+func Callbackify1[A any, V any](fn func(a A) Promise) func(a A, callback func(V, error)) {
+	return func(a A, callback func(V, error)) {
+		toCallback[V](func() Promise {
+			return fn(a)
+		}, callback)
+	}
+}
+
+func Callbackify2[A any, B any, V any](fn func(a A, b B) Promise) func(a A, b B, callback func(V, error)) {
+	return func(a A, b B, callback func(V, error)) {
+		toCallback[V](func() Promise {
+			return fn(a, b)
+		}, callback)
+	}
+}
+
+func Callbackify3[A any, B any, C any, V any](fn func(a A, b B, c C) Promise) func(a A, b B, c C, callback func(V, error)) {
+	return func(a A, b B, c C, callback func(V, error)) {
+		toCallback[V](func() Promise {
+			return fn(a, b, c)
+		}, callback)
+	}
+}
+
+func fromCallback[V any](call func(callback func(V, error))) Promise {
+	return New(func(resolve ResolveFunc[V], reject RejectFunc) {
+		once := &sync.Once{}
+		done := make(chan struct{})
+		var result SettledResult[V]
+
+		call(func(value V, err error) {
+			once.Do(func() {
+				result.Value, result.Error = value, err
+				close(done)
+			})
+		})
+		resume := suspend()
+		<-done
+		resume()
+
+		if result.Error != nil {
+			reject(result.Error)
+			return
+		}
+
+		resolve(result.Value)
+	})
+}
+
+func toCallback[V any](factory func() Promise, callback func(V, error)) {
+	currentExecutor(nil).Submit(func() {
+		callback(Await[V](factory()))
+	})
+}
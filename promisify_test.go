@@ -0,0 +1,169 @@
+package go_promise
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestPromisify(t *testing.T) {
+	t.Run("it should resolve callback value", func(t *testing.T) {
+		factory := Promisify(func(callback func(int, error)) {
+			go callback(10, nil)
+		})
+
+		result, err := Await[int](factory())
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+	})
+
+	t.Run("it should reject callback error", func(t *testing.T) {
+		expected := errors.New("error")
+		factory := Promisify(func(callback func(int, error)) {
+			callback(0, expected)
+		})
+
+		_, err := Await[int](factory())
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should settle only once", func(t *testing.T) {
+		factory := Promisify(func(callback func(int, error)) {
+			callback(10, nil)
+			callback(20, nil)
+			callback(0, errors.New("error"))
+		})
+
+		result, err := Await[int](factory())
+		if err != nil || result != 10 {
+			t.Error("result is not 10")
+		}
+	})
+
+	t.Run("it should pass arguments", func(t *testing.T) {
+		join := Promisify3(func(a int, b string, c bool, callback func(string, error)) {
+			callback(strconv.Itoa(a)+b+strconv.FormatBool(c), nil)
+		})
+		first := Promisify1(func(a int, callback func(int, error)) {
+			callback(a, nil)
+		})
+		second := Promisify2(func(a int, b int, callback func(int, error)) {
+			callback(a+b, nil)
+		})
+
+		joined, _ := Await[string](join(1, "-", true))
+		if joined != "1-true" {
+			t.Error("result is not 1-true")
+		}
+		value, _ := Await[int](first(1))
+		if value != 1 {
+			t.Error("result is not 1")
+		}
+		value, _ = Await[int](second(1, 2))
+		if value != 3 {
+			t.Error("result is not 3")
+		}
+	})
+}
+
+func TestPromisifyContext(t *testing.T) {
+	t.Run("it should pass context and arguments", func(t *testing.T) {
+		type key struct{}
+		ctx := context.WithValue(context.Background(), key{}, 10)
+
+		fn := PromisifyContext2(func(ctx context.Context, a int, b int) (int, error) {
+			return ctx.Value(key{}).(int) + a + b, nil
+		})
+
+		result, err := Await[int](fn(ctx, 1, 2))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 13 {
+			t.Error("result is not 13")
+		}
+	})
+
+	t.Run("it should reject error", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		fn := PromisifyContext(func(ctx context.Context) (int, error) {
+			return 0, ctx.Err()
+		})
+
+		_, err := Await[int](fn(ctx))
+		if err != context.Canceled {
+			t.Error("error is not as expected")
+		}
+	})
+}
+
+func TestCallbackify(t *testing.T) {
+	t.Run("it should call callback with value", func(t *testing.T) {
+		results := make(chan SettledResult[int], 1)
+
+		fn := Callbackify(FunctionFactory(func() (int, error) {
+			return 10, nil
+		}))
+		fn(func(value int, err error) {
+			results <- SettledResult[int]{Value: value, Error: err}
+		})
+
+		result := <-results
+		if result.Error != nil || result.Value != 10 {
+			t.Error("result is not 10")
+		}
+	})
+
+	t.Run("it should call callback with error", func(t *testing.T) {
+		expected := errors.New("error")
+		results := make(chan SettledResult[int], 1)
+
+		fn := Callbackify1[int, int](func(a int) Promise {
+			return Reject(expected)
+		})
+		fn(1, func(value int, err error) {
+			results <- SettledResult[int]{Value: value, Error: err}
+		})
+
+		result := <-results
+		if result.Error != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should call callback with invalid type error", func(t *testing.T) {
+		results := make(chan SettledResult[int], 1)
+
+		fn := Callbackify2[int, int, int](func(a int, b int) Promise {
+			return Resolve(strconv.Itoa(a + b))
+		})
+		fn(1, 2, func(value int, err error) {
+			results <- SettledResult[int]{Value: value, Error: err}
+		})
+
+		result := <-results
+		if !errors.Is(result.Error, InvalidTypeErr) {
+			t.Error("invalid type error is expected")
+		}
+	})
+
+	t.Run("it should round trip with promisify", func(t *testing.T) {
+		fn := Promisify3(Callbackify3[int, int, int, int](func(a int, b int, c int) Promise {
+			return Resolve(a + b + c)
+		}))
+
+		result, err := Await[int](fn(1, 2, 3))
+		if err != nil || result != 6 {
+			t.Error("result is not 6")
+		}
+	})
+}
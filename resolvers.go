@@ -3,6 +3,8 @@ package go_promise
 import (
 	"errors"
 	"strings"
+	"sync"
+	"sync/atomic"
 )
 
 type Errors []error
@@ -59,19 +61,63 @@ func (rs SettledResults[V]) Errors() Errors {
 	return errs
 }
 
-type settledResultChanel[V any] chan SettledResult[V]
+type settledResult[V any] struct {
+	SettledResult[V]
+	source Awaiter
+}
 
-func (c settledResultChanel[V]) empty() bool {
-	boolChan := make(chan bool)
-	defer close(boolChan)
+func (r settledResult[V]) observe() {
+	markHandled(r.source)
+}
 
-	go func() {
-		for range c {
-		}
-		boolChan <- true
-	}()
+func (r settledResult[V]) drop() {
+	r.discard()
+
+	source, ok := r.source.(observable)
+	if ok && r.Error != nil && unhandledRejections.isTracking() {
+		unhandledRejections.report(source.metadata(), r.Error)
+	}
+}
+
+func (r settledResult[V]) discard() {
+	source, ok := r.source.(observable)
+	if !ok {
+		return
+	}
+
+	source.metadata().emit(Event{
+		Kind:  EventDrop,
+		Error: r.Error,
+	})
+	if r.Error != nil {
+		markHandled(r.source)
+	}
+}
+
+type settledResultChanel[V any] chan settledResult[V]
+
+func (c settledResultChanel[V]) dropNext() {
+	result := <-c
+	result.drop()
+}
+
+func (c settledResultChanel[V]) discardNext() {
+	result := <-c
+	result.discard()
+}
+
+func (c settledResultChanel[V]) discardAll() {
+	for result := range c {
+		result.discard()
+	}
+}
 
-	return <-boolChan
+func (c settledResultChanel[V]) empty() bool {
+	for result := range c {
+		result.drop()
+	}
+
+	return true
 }
 
 type ResolveFunc[V any] func(value V)
@@ -80,14 +126,35 @@ type RejectFunc func(err error)
 
 type ExecuteFunc[V any] func(resolve ResolveFunc[V], reject RejectFunc)
 
-func createResolveMethod[V any](valueChan chan V) ResolveFunc[V] {
-	return func(value V) {
-		valueChan <- value
-	}
+type settlement[V any] struct {
+	state int32
+	value V
+	err   error
+	done  sync.WaitGroup
 }
 
-func createRejectMethod(errChan chan error) RejectFunc {
-	return func(err error) {
-		errChan <- err
+func newSettlement[V any]() *settlement[V] {
+	s := &settlement[V]{}
+	s.done.Add(1)
+
+	return s
+}
+
+func (s *settlement[V]) resolve(value V) {
+	s.settle(value, nil)
+}
+
+func (s *settlement[V]) reject(err error) {
+	var empty V
+	s.settle(empty, err)
+}
+
+func (s *settlement[V]) settle(value V, err error) {
+	if !atomic.CompareAndSwapInt32(&s.state, 0, 1) {
+		return
 	}
+
+	s.value = value
+	s.err = err
+	s.done.Done()
 }
@@ -226,40 +226,55 @@ func TestSettledResults_Errors(t *testing.T) {
 	})
 }
 
-func Test_createResolveMethod(t *testing.T) {
-	valueChan := make(chan int)
-	f := createResolveMethod(valueChan)
+func Test_settlement_resolve(t *testing.T) {
+	s := newSettlement[int]()
 
 	go func() {
-		f(10)
+		s.resolve(10)
 	}()
 
-	if 10 != <-valueChan {
+	s.done.Wait()
+	if s.value != 10 || s.err != nil {
 		t.Error("result is not 10")
 	}
 }
 
-func Test_createRejectMethod(t *testing.T) {
-	errChan := make(chan error)
-	f := createRejectMethod(errChan)
+func Test_settlement_reject(t *testing.T) {
+	s := newSettlement[int]()
 	err := errors.New("error")
 
 	go func() {
-		f(err)
+		s.reject(err)
 	}()
 
-	if !reflect.DeepEqual(err, <-errChan) {
+	s.done.Wait()
+	if !reflect.DeepEqual(err, s.err) {
 		t.Error("result is not expected error")
 	}
 }
 
+func Test_settlement_settle(t *testing.T) {
+	t.Run("it should settle only once", func(t *testing.T) {
+		s := newSettlement[int]()
+
+		s.resolve(10)
+		s.reject(errors.New("error"))
+		s.resolve(20)
+
+		s.done.Wait()
+		if s.value != 10 || s.err != nil {
+			t.Error("result is not 10")
+		}
+	})
+}
+
 func Test_settledResultChanel_empty(t *testing.T) {
 	resultChanel := make(settledResultChanel[int])
 	go func() {
-		resultChanel <- SettledResult[int]{}
-		resultChanel <- SettledResult[int]{}
-		resultChanel <- SettledResult[int]{}
-		resultChanel <- SettledResult[int]{}
+		resultChanel <- settledResult[int]{}
+		resultChanel <- settledResult[int]{}
+		resultChanel <- settledResult[int]{}
+		resultChanel <- settledResult[int]{}
 		close(resultChanel)
 	}()
 
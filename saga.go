@@ -0,0 +1,59 @@
+package go_promise
+
+type CompensateFunc func(value any) error
+
+type sagaStep struct {
+	factory    func() Promise
+	compensate CompensateFunc
+}
+
+type Saga struct {
+	steps []sagaStep
+}
+
+func NewSaga() *Saga {
+	return &Saga{}
+}
+
+func (s *Saga) Step(factory func() Promise, compensate CompensateFunc) *Saga {
+	s.steps = append(s.steps, sagaStep{
+		factory:    factory,
+		compensate: compensate,
+	})
+
+	return s
+}
+
+func (s *Saga) Run() Promise {
+	steps := append([]sagaStep{}, s.steps...)
+
+	return New(func(resolve ResolveFunc[[]any], reject RejectFunc) {
+		values := make([]any, 0, len(steps))
+		for _, step := range steps {
+			value, err := step.factory().Await()
+			if err != nil {
+				reject(compensate(steps[:len(values)], values, err))
+				return
+			}
+
+			values = append(values, value)
+		}
+
+		resolve(values)
+	})
+}
+
+func compensate(steps []sagaStep, values []any, err error) Errors {
+	errs := Errors{err}
+	for i := len(steps) - 1; i >= 0; i-- {
+		if steps[i].compensate == nil {
+			continue
+		}
+
+		if compensationErr := steps[i].compensate(values[i]); compensationErr != nil {
+			errs = append(errs, compensationErr)
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,109 @@
+package go_promise
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSaga_Run(t *testing.T) {
+	t.Run("it should resolve values of all steps", func(t *testing.T) {
+		saga := NewSaga().
+			Step(func() Promise {
+				return Resolve(1)
+			}, nil).
+			Step(func() Promise {
+				return Resolve("two")
+			}, nil)
+
+		result, err := Await[[]any](saga.Run())
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(result, []any{1, "two"}) {
+			t.Error("result is not as expected")
+		}
+	})
+
+	t.Run("it should compensate completed steps in reverse order", func(t *testing.T) {
+		expected := errors.New("error")
+		compensated := []any{}
+		record := func(value any) error {
+			compensated = append(compensated, value)
+			return nil
+		}
+		thirdCalled := false
+
+		saga := NewSaga().
+			Step(func() Promise {
+				return Resolve(1)
+			}, record).
+			Step(func() Promise {
+				return Resolve(2)
+			}, record).
+			Step(func() Promise {
+				return Reject(expected)
+			}, func(any) error {
+				thirdCalled = true
+				return nil
+			}).
+			Step(func() Promise {
+				return Resolve(4)
+			}, record)
+
+		_, err := Await[[]any](saga.Run())
+		if !reflect.DeepEqual(err, Errors{expected}) {
+			t.Error("error is not as expected")
+		}
+		if !reflect.DeepEqual(compensated, []any{2, 1}) {
+			t.Error("steps are not compensated in reverse order")
+		}
+		if thirdCalled {
+			t.Error("failed step is compensated")
+		}
+	})
+
+	t.Run("it should aggregate compensation errors", func(t *testing.T) {
+		expected := errors.New("error")
+		first := errors.New("first")
+		second := errors.New("second")
+
+		saga := NewSaga().
+			Step(func() Promise {
+				return Resolve(1)
+			}, func(any) error {
+				return first
+			}).
+			Step(func() Promise {
+				return Resolve(2)
+			}, func(any) error {
+				return second
+			}).
+			Step(func() Promise {
+				return Reject(expected)
+			}, nil)
+
+		_, err := Await[[]any](saga.Run())
+		if !reflect.DeepEqual(err, Errors{expected, second, first}) {
+			t.Error("errors are not as expected")
+		}
+	})
+
+	t.Run("it should create fresh promises on each run", func(t *testing.T) {
+		counter := 0
+		saga := NewSaga().Step(func() Promise {
+			return Function(func() (int, error) {
+				counter++
+				return counter, nil
+			})
+		}, nil)
+
+		promise := saga.Run()
+		_, _ = Await[[]any](promise)
+		promise.Reset()
+		result, _ := Await[[]any](promise)
+		if !reflect.DeepEqual(result, []any{2}) {
+			t.Error("result is not as expected")
+		}
+	})
+}
@@ -0,0 +1,110 @@
+package go_promise
+
+import (
+	"context"
+	"sync"
+)
+
+type Scope struct {
+	ctx     context.Context
+	cancel  context.CancelFunc
+	group   *sync.WaitGroup
+	mutex   *sync.Mutex
+	results SettledResults[any]
+	err     error
+}
+
+func NewScope(ctx context.Context) *Scope {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &Scope{
+		ctx:    ctx,
+		cancel: cancel,
+		group:  &sync.WaitGroup{},
+		mutex:  &sync.Mutex{},
+	}
+}
+
+func (s *Scope) Context() context.Context {
+	return s.ctx
+}
+
+func (s *Scope) Cancel() {
+	s.cancel()
+}
+
+func (s *Scope) Go(fn func(ctx context.Context) Promise) Promise {
+	s.mutex.Lock()
+	index := len(s.results)
+	s.results = append(s.results, SettledResult[any]{})
+	s.mutex.Unlock()
+
+	done := make(chan struct{})
+	s.spawn(currentExecutor(nil), func() {
+		defer close(done)
+
+		var value any
+		err := s.ctx.Err()
+		if err == nil {
+			promise := fn(s.ctx)
+			if scoped, ok := promise.(observable); ok {
+				scoped.metadata().attach(s)
+			}
+			value, err = promise.Await()
+		}
+
+		s.mutex.Lock()
+		s.results[index] = SettledResult[any]{
+			Value: value,
+			Error: err,
+		}
+		if err != nil && s.err == nil {
+			s.err = err
+			s.cancel()
+		}
+		s.mutex.Unlock()
+	})
+
+	return New(func(resolve ResolveFunc[any], reject RejectFunc) {
+		resume := suspend()
+		<-done
+		resume()
+
+		s.mutex.Lock()
+		result := s.results[index]
+		s.mutex.Unlock()
+
+		if result.Error != nil {
+			reject(result.Error)
+		} else {
+			resolve(result.Value)
+		}
+	})
+}
+
+func (s *Scope) Wait() Promise {
+	return New(func(resolve ResolveFunc[[]any], reject RejectFunc) {
+		resume := suspend()
+		s.group.Wait()
+		resume()
+		s.cancel()
+
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+
+		if s.err != nil {
+			reject(s.err)
+			return
+		}
+
+		resolve(s.results.Values())
+	})
+}
+
+func (s *Scope) spawn(executor Executor, task func()) {
+	s.group.Add(1)
+	executor.Submit(func() {
+		defer s.group.Done()
+		task()
+	})
+}
@@ -0,0 +1,121 @@
+package go_promise
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func TestScope_Go(t *testing.T) {
+	t.Run("it should resolve values", func(t *testing.T) {
+		scope := NewScope(context.Background())
+
+		first := scope.Go(func(ctx context.Context) Promise {
+			return Resolve(10)
+		})
+		scope.Go(func(ctx context.Context) Promise {
+			return Function(func() (int, error) {
+				time.Sleep(10 * time.Millisecond)
+				return 11, nil
+			})
+		})
+
+		result, err := Await[int](first)
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 10 {
+			t.Error("result is not 10")
+		}
+
+		values, err := Await[[]any](scope.Wait())
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(values, []any{10, 11}) {
+			t.Error("values are not 10 and 11")
+		}
+	})
+
+	t.Run("it should cancel the rest after the first failure", func(t *testing.T) {
+		expected := errors.New("error")
+		scope := NewScope(context.Background())
+
+		canceled := scope.Go(func(ctx context.Context) Promise {
+			return Function(func() (int, error) {
+				<-ctx.Done()
+				return 0, ctx.Err()
+			})
+		})
+		scope.Go(func(ctx context.Context) Promise {
+			return Reject(expected)
+		})
+
+		_, err := Await[[]any](scope.Wait())
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+
+		_, err = Await[int](canceled)
+		if err != context.Canceled {
+			t.Error("canceled error is expected")
+		}
+	})
+
+	t.Run("it should not start after cancellation", func(t *testing.T) {
+		executed := false
+		scope := NewScope(context.Background())
+		scope.Cancel()
+
+		_, err := Await[int](scope.Go(func(ctx context.Context) Promise {
+			executed = true
+			return Resolve(10)
+		}))
+		if err != context.Canceled {
+			t.Error("canceled error is expected")
+		}
+		if executed {
+			t.Error("function is executed")
+		}
+	})
+
+	t.Run("it should wait for dropped results", func(t *testing.T) {
+		before := runtime.NumGoroutine()
+		scope := NewScope(context.Background())
+
+		start := time.Now()
+		scope.Go(func(ctx context.Context) Promise {
+			return Race[int](Promises{
+				Resolve(10),
+				Function(func() (int, error) {
+					time.Sleep(50 * time.Millisecond)
+					return 11, nil
+				}),
+			}).With(Then(func(value int) (int, error) {
+				return value, nil
+			}))
+		})
+
+		values, err := Await[[]any](scope.Wait())
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(values, []any{10}) {
+			t.Error("values are not 10")
+		}
+		if time.Since(start) < 50*time.Millisecond {
+			t.Error("scope does not wait for dropped results")
+		}
+
+		deadline := time.Now().Add(time.Second)
+		for runtime.NumGoroutine() > before && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		if runtime.NumGoroutine() > before {
+			t.Error("goroutines are left behind")
+		}
+	})
+}
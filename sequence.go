@@ -0,0 +1,122 @@
+package go_promise
+
+type SequenceOption func(settings *sequenceSettings)
+
+type sequenceSettings struct {
+	continueOnError bool
+}
+
+func ContinueOnError() SequenceOption {
+	return func(settings *sequenceSettings) {
+		settings.continueOnError = true
+	}
+}
+
+func newSequenceSettings(options []SequenceOption) sequenceSettings {
+	settings := sequenceSettings{}
+	for _, option := range options {
+		option(&settings)
+	}
+
+	return settings
+}
+
+func Series[V any](factories []Factory[V], options ...SequenceOption) Promise {
+	settings := newSequenceSettings(options)
+
+	return New(func(resolve ResolveFunc[[]V], reject RejectFunc) {
+		values := make([]V, 0, len(factories))
+		errs := Errors{}
+		for _, factory := range factories {
+			value, err := Await[V](factory())
+			if err != nil {
+				if !settings.continueOnError {
+					reject(err)
+					return
+				}
+				errs = append(errs, err)
+				continue
+			}
+
+			values = append(values, value)
+		}
+
+		if len(errs) > 0 {
+			reject(errs)
+			return
+		}
+
+		resolve(values)
+	})
+}
+
+type WaterfallFunc[V any] func(value V) Promise
+
+func Waterfall[V any](initial V, steps []WaterfallFunc[V], options ...SequenceOption) Promise {
+	settings := newSequenceSettings(options)
+
+	return New(func(resolve ResolveFunc[V], reject RejectFunc) {
+		value := initial
+		errs := Errors{}
+		for _, step := range steps {
+			next, err := Await[V](step(value))
+			if err != nil {
+				if !settings.continueOnError {
+					reject(err)
+					return
+				}
+				errs = append(errs, err)
+				continue
+			}
+
+			value = next
+		}
+
+		if len(errs) > 0 {
+			reject(errs)
+			return
+		}
+
+		resolve(value)
+	})
+}
+
+type ReduceFunc[V any, A any] func(accumulator A, value V) (A, error)
+
+func Reduce[V any, A any, P Awaiter](ps []P, initial A, fn ReduceFunc[V, A], options ...SequenceOption) Promise {
+	settings := newSequenceSettings(options)
+
+	return New(func(resolve ResolveFunc[A], reject RejectFunc) {
+		accumulator := initial
+		errs := Errors{}
+		for _, promise := range ps {
+			value, err := Await[V](promise)
+			if err == nil {
+				accumulator, err = reduceStep(accumulator, value, fn)
+			}
+			if err != nil {
+				if !settings.continueOnError {
+					reject(err)
+					return
+				}
+				errs = append(errs, err)
+			}
+		}
+
+		if len(errs) > 0 {
+			reject(errs)
+			return
+		}
+
+		resolve(accumulator)
+	}, dependsOn(ps))
+}
+
+func reduceStep[V any, A any](accumulator A, value V, fn ReduceFunc[V, A]) (A, error) {
+	next, err := fn(accumulator, value)
+	if err != nil {
+		return accumulator, err
+	}
+
+	return next, nil
+}
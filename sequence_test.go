@@ -0,0 +1,186 @@
+package go_promise
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestSeries(t *testing.T) {
+	t.Run("it should run factories in order", func(t *testing.T) {
+		order := []int{}
+		factories := make([]Factory[int], 0, 3)
+		for i := 0; i < 3; i++ {
+			value := i
+			factories = append(factories, FunctionFactory(func() (int, error) {
+				time.Sleep(time.Duration(3-value) * 5 * time.Millisecond)
+				order = append(order, value)
+				return value * 10, nil
+			}))
+		}
+
+		result, err := Await[[]int](Series(factories))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(result, []int{0, 10, 20}) {
+			t.Error("result is not as expected")
+		}
+		if !reflect.DeepEqual(order, []int{0, 1, 2}) {
+			t.Error("factories are not run in order")
+		}
+	})
+
+	t.Run("it should stop at first rejection", func(t *testing.T) {
+		expected := errors.New("error")
+		called := false
+
+		_, err := Await[[]int](Series([]Factory[int]{
+			FunctionFactory(func() (int, error) {
+				return 0, expected
+			}),
+			FunctionFactory(func() (int, error) {
+				called = true
+				return 1, nil
+			}),
+		}))
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+		if called {
+			t.Error("factory after rejection is called")
+		}
+	})
+
+	t.Run("it should continue past failures", func(t *testing.T) {
+		expected := errors.New("error")
+		called := false
+
+		_, err := Await[[]int](Series([]Factory[int]{
+			FunctionFactory(func() (int, error) {
+				return 0, expected
+			}),
+			FunctionFactory(func() (int, error) {
+				called = true
+				return 1, nil
+			}),
+		}, ContinueOnError()))
+		if !reflect.DeepEqual(err, Errors{expected}) {
+			t.Error("error is not as expected")
+		}
+		if !called {
+			t.Error("factory after rejection is not called")
+		}
+	})
+}
+
+func TestWaterfall(t *testing.T) {
+	double := func(value int) Promise {
+		return Resolve(value * 2)
+	}
+
+	t.Run("it should pass each result to the next step", func(t *testing.T) {
+		result, err := Await[int](Waterfall(1, []WaterfallFunc[int]{double, double, double}))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != 8 {
+			t.Error("result is not 8")
+		}
+	})
+
+	t.Run("it should stop at first rejection", func(t *testing.T) {
+		expected := errors.New("error")
+		called := false
+
+		_, err := Await[int](Waterfall(1, []WaterfallFunc[int]{
+			func(int) Promise {
+				return Reject(expected)
+			},
+			func(value int) Promise {
+				called = true
+				return Resolve(value)
+			},
+		}))
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+		if called {
+			t.Error("step after rejection is called")
+		}
+	})
+
+	t.Run("it should pass previous result past failures", func(t *testing.T) {
+		expected := errors.New("error")
+		passed := 0
+
+		_, err := Await[int](Waterfall(1, []WaterfallFunc[int]{
+			double,
+			func(int) Promise {
+				return Reject(expected)
+			},
+			func(value int) Promise {
+				passed = value
+				return Resolve(value)
+			},
+		}, ContinueOnError()))
+		if !reflect.DeepEqual(err, Errors{expected}) {
+			t.Error("error is not as expected")
+		}
+		if passed != 2 {
+			t.Error("passed value is not 2")
+		}
+	})
+}
+
+func TestReduce(t *testing.T) {
+	sum := func(accumulator int, value int) (int, error) {
+		return accumulator + value, nil
+	}
+
+	t.Run("it should fold results in order", func(t *testing.T) {
+		result, err := Await[[]int](Reduce(Promises{Resolve(1), Resolve(2), Resolve(3)}, []int{}, func(accumulator []int, value int) ([]int, error) {
+			return append(accumulator, value), nil
+		}))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Error("result is not as expected")
+		}
+	})
+
+	t.Run("it should stop at first rejection", func(t *testing.T) {
+		expected := errors.New("error")
+
+		_, err := Await[int](Reduce(Promises{Resolve(1), Reject(expected), Resolve(3)}, 0, sum))
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should stop at reducer error", func(t *testing.T) {
+		expected := errors.New("error")
+
+		_, err := Await[int](Reduce(Promises{Resolve(1)}, 0, func(int, int) (int, error) {
+			return 0, expected
+		}))
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should skip failures", func(t *testing.T) {
+		expected := errors.New("error")
+
+		_, err := Await[int](Reduce(Promises{Resolve(1), Reject(expected), Resolve("3"), Resolve(3)}, 0, sum, ContinueOnError()))
+		errs, ok := err.(Errors)
+		if !ok || len(errs) != 2 {
+			t.Fatal("errors are not as expected")
+		}
+		if errs[0] != expected || !errors.Is(errs[1], InvalidTypeErr) {
+			t.Error("errors are not as expected")
+		}
+	})
+}
@@ -0,0 +1,255 @@
+package go_promise
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+var EndOfStreamErr = errors.New("promise.endOfStream")
+
+type Stream[V any] interface {
+	Next() Promise
+}
+
+type StreamFunc[V any] func() Promise
+
+func (f StreamFunc[V]) Next() Promise {
+	return f()
+}
+
+func FromSlice[V any](values []V) Stream[V] {
+	mutex := &sync.Mutex{}
+	index := 0
+
+	return StreamFunc[V](func() Promise {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if index >= len(values) {
+			return Reject(EndOfStreamErr)
+		}
+
+		value := values[index]
+		index++
+
+		return Resolve(value)
+	})
+}
+
+func FromFunc[V any](fn PromiseFunc[V]) Stream[V] {
+	return sequenced(fn)
+}
+
+func Map[V, W any](stream Stream[V], then ThenFunc[V, W]) Stream[W] {
+	return StreamFunc[W](func() Promise {
+		return stream.Next().With(Then(then))
+	})
+}
+
+func Filter[V any](stream Stream[V], filter func(value V) bool) Stream[V] {
+	return sequenced(func() (V, error) {
+		for {
+			value, err := Await[V](stream.Next())
+			if err != nil {
+				return value, err
+			}
+
+			if filter(value) {
+				return value, nil
+			}
+		}
+	})
+}
+
+func Take[V any](stream Stream[V], count int) Stream[V] {
+	mutex := &sync.Mutex{}
+	taken := 0
+
+	return StreamFunc[V](func() Promise {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if taken >= count {
+			return Reject(EndOfStreamErr)
+		}
+		taken++
+
+		return stream.Next()
+	})
+}
+
+func Buffer[V any](ctx context.Context, stream Stream[V], size int) Stream[V] {
+	return fromChannel(ctx, size, []Stream[V]{stream})
+}
+
+func Merge[V any](ctx context.Context, streams ...Stream[V]) Stream[V] {
+	return fromChannel(ctx, len(streams), streams)
+}
+
+func Collect[V any](stream Stream[V]) Promise {
+	return Function(func() ([]V, error) {
+		values := make([]V, 0)
+		for {
+			value, err := Await[V](stream.Next())
+			if errors.Is(err, EndOfStreamErr) {
+				return values, nil
+			}
+			if err != nil {
+				return nil, err
+			}
+
+			values = append(values, value)
+		}
+	})
+}
+
+// sequenced calls next once for each Next, in the order of the Next calls, no
+// matter in which order the promises are awaited.
+func sequenced[V any](next PromiseFunc[V]) Stream[V] {
+	mutex := &sync.Mutex{}
+	var previous Promise
+
+	return StreamFunc[V](func() Promise {
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		prev := previous
+		current := New(func(resolve ResolveFunc[V], reject RejectFunc) {
+			if prev != nil {
+				_, err := awaitSilently(prev)
+				prev = nil
+				if errors.Is(err, EndOfStreamErr) {
+					reject(EndOfStreamErr)
+					return
+				}
+			}
+
+			value, err := next()
+			if err != nil {
+				reject(err)
+				return
+			}
+
+			resolve(value)
+		})
+		previous = current
+
+		return current
+	})
+}
+
+func fromChannel[V any](ctx context.Context, size int, streams []Stream[V]) Stream[V] {
+	if size < 1 {
+		size = 1
+	}
+
+	buffer := &streamBuffer[V]{
+		ctx:     ctx,
+		mutex:   &sync.Mutex{},
+		results: make(chan SettledResult[V], size),
+		size:    size,
+		idle:    append([]Stream[V]{}, streams...),
+		active:  len(streams),
+	}
+	if len(streams) == 0 {
+		close(buffer.results)
+	}
+	once := &sync.Once{}
+	stream := sequenced(buffer.next)
+
+	return StreamFunc[V](func() Promise {
+		once.Do(buffer.fill)
+
+		return stream.Next()
+	})
+}
+
+// streamBuffer reads ahead from its streams, one element per task on the
+// executor, while there is room for the result.
+type streamBuffer[V any] struct {
+	ctx     context.Context
+	mutex   *sync.Mutex
+	results chan SettledResult[V]
+	size    int
+	pending int
+	idle    []Stream[V]
+	active  int
+}
+
+func (b *streamBuffer[V]) fill() {
+	b.mutex.Lock()
+	streams := make([]Stream[V], 0, len(b.idle))
+	for b.pending < b.size && len(b.idle) > 0 && b.ctx.Err() == nil {
+		streams = append(streams, b.idle[0])
+		b.idle = b.idle[1:]
+		b.pending++
+	}
+	b.mutex.Unlock()
+
+	for _, stream := range streams {
+		s := stream
+		currentExecutor(nil).Submit(func() {
+			b.fetch(s)
+		})
+	}
+}
+
+func (b *streamBuffer[V]) fetch(stream Stream[V]) {
+	value, err := Await[V](stream.Next())
+
+	b.mutex.Lock()
+	if errors.Is(err, EndOfStreamErr) {
+		b.pending--
+		b.finish()
+	} else {
+		b.results <- SettledResult[V]{Value: value, Error: err}
+		if err != nil {
+			b.finish()
+		} else {
+			b.idle = append(b.idle, stream)
+		}
+	}
+	b.mutex.Unlock()
+
+	b.fill()
+}
+
+func (b *streamBuffer[V]) finish() {
+	b.active--
+	if b.active == 0 {
+		close(b.results)
+	}
+}
+
+func (b *streamBuffer[V]) next() (V, error) {
+	var empty V
+	if err := b.ctx.Err(); err != nil {
+		return empty, err
+	}
+
+	resume := suspend()
+	var result SettledResult[V]
+	var ok bool
+	var err error
+	select {
+	case result, ok = <-b.results:
+		if !ok {
+			err = EndOfStreamErr
+		}
+	case <-b.ctx.Done():
+		err = b.ctx.Err()
+	}
+	resume()
+
+	if err != nil {
+		return empty, err
+	}
+
+	b.mutex.Lock()
+	b.pending--
+	b.mutex.Unlock()
+	b.fill()
+
+	return result.Value, result.Error
+}
@@ -0,0 +1,289 @@
+package go_promise
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"sort"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestFromSlice(t *testing.T) {
+	stream := FromSlice([]int{10, 11})
+
+	for _, expected := range []int{10, 11} {
+		result, err := Await[int](stream.Next())
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if result != expected {
+			t.Errorf("result is not %d", expected)
+		}
+	}
+
+	_, err := Await[int](stream.Next())
+	if err != EndOfStreamErr {
+		t.Error("end of stream is expected")
+	}
+
+	t.Run("it should reserve elements in order of next", func(t *testing.T) {
+		stream := FromSlice([]int{10, 11})
+
+		first, second := stream.Next(), stream.Next()
+		secondResult, _ := Await[int](second)
+		firstResult, _ := Await[int](first)
+		if firstResult != 10 || secondResult != 11 {
+			t.Error("results are not in order of next")
+		}
+	})
+}
+
+func TestFromFunc(t *testing.T) {
+	t.Run("it should reserve calls in order of next", func(t *testing.T) {
+		counter := 0
+		stream := FromFunc(func() (int, error) {
+			counter++
+			return counter, nil
+		})
+
+		first, second := stream.Next(), stream.Next()
+		secondResult, _ := Await[int](second)
+		firstResult, _ := Await[int](first)
+		if firstResult != 1 || secondResult != 2 {
+			t.Error("results are not in order of next")
+		}
+	})
+
+	t.Run("it should end the stream", func(t *testing.T) {
+		page := 0
+
+		stream := FromFunc(func() ([]int, error) {
+			if page == 2 {
+				return nil, EndOfStreamErr
+			}
+			page++
+			return []int{page}, nil
+		})
+
+		result, err := Await[[][]int](Collect[[]int](stream))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(result, [][]int{{1}, {2}}) {
+			t.Error("result is not as expected")
+		}
+
+		_, err = Await[[]int](stream.Next())
+		if err != EndOfStreamErr {
+			t.Error("end of stream is expected")
+		}
+	})
+
+	t.Run("it should reject error", func(t *testing.T) {
+		expected := errors.New("error")
+
+		_, err := Await[[]int](Collect[int](FromFunc(func() (int, error) {
+			return 0, expected
+		})))
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+}
+
+func TestMap(t *testing.T) {
+	t.Run("it should map elements", func(t *testing.T) {
+		stream := Map(FromSlice([]int{10, 11}), func(value int) (string, error) {
+			return strconv.Itoa(value), nil
+		})
+
+		result, err := Await[[]string](Collect[string](stream))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(result, []string{"10", "11"}) {
+			t.Error("result is not as expected")
+		}
+	})
+
+	t.Run("it should keep order of next", func(t *testing.T) {
+		stream := Map(FromSlice([]int{10, 11}), func(value int) (string, error) {
+			return strconv.Itoa(value), nil
+		})
+
+		first, second := stream.Next(), stream.Next()
+		secondResult, _ := Await[string](second)
+		firstResult, _ := Await[string](first)
+		if firstResult != "10" || secondResult != "11" {
+			t.Error("results are not in order of next")
+		}
+	})
+}
+
+func TestFilter(t *testing.T) {
+	t.Run("it should filter elements", func(t *testing.T) {
+		stream := Filter(FromSlice([]int{10, 11, 12, 13}), func(value int) bool {
+			return value%2 == 0
+		})
+
+		result, err := Await[[]int](Collect[int](stream))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(result, []int{10, 12}) {
+			t.Error("result is not as expected")
+		}
+	})
+
+	t.Run("it should keep order of next", func(t *testing.T) {
+		stream := Filter(FromSlice([]int{1, 2, 3, 4}), func(value int) bool {
+			return value%2 == 0
+		})
+
+		first, second := stream.Next(), stream.Next()
+		secondResult, _ := Await[int](second)
+		firstResult, _ := Await[int](first)
+		if firstResult != 2 || secondResult != 4 {
+			t.Error("results are not in order of next")
+		}
+	})
+}
+
+func TestTake(t *testing.T) {
+	t.Run("it should take elements", func(t *testing.T) {
+		pulled := 0
+		stream := Take[int](FromFunc(func() (int, error) {
+			pulled++
+			return pulled, nil
+		}), 3)
+
+		result, err := Await[[]int](Collect[int](stream))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(result, []int{1, 2, 3}) {
+			t.Error("result is not as expected")
+		}
+		if pulled != 3 {
+			t.Error("pulled is not 3")
+		}
+	})
+
+	t.Run("it should keep order of next", func(t *testing.T) {
+		stream := Take[int](FromSlice([]int{10, 11, 12}), 2)
+
+		first, second := stream.Next(), stream.Next()
+		secondResult, _ := Await[int](second)
+		firstResult, _ := Await[int](first)
+		if firstResult != 10 || secondResult != 11 {
+			t.Error("results are not in order of next")
+		}
+	})
+}
+
+func TestBuffer(t *testing.T) {
+	t.Run("it should prefetch elements", func(t *testing.T) {
+		pulled := make(chan int, 10)
+		count := 0
+
+		stream := Buffer[int](context.Background(), FromFunc(func() (int, error) {
+			if count == 3 {
+				return 0, EndOfStreamErr
+			}
+			count++
+			pulled <- count
+			return count, nil
+		}), 3)
+
+		first, err := Await[int](stream.Next())
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if first != 1 {
+			t.Error("result is not 1")
+		}
+
+		for i := 1; i <= 3; i++ {
+			select {
+			case <-pulled:
+			case <-time.After(time.Second):
+				t.Fatal("elements are not prefetched")
+			}
+		}
+
+		result, err := Await[[]int](Collect[int](stream))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+		if !reflect.DeepEqual(result, []int{2, 3}) {
+			t.Error("result is not as expected")
+		}
+	})
+
+	t.Run("it should stop when context is canceled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		stream := Buffer[int](ctx, FromFunc(func() (int, error) {
+			return 10, nil
+		}), 0)
+
+		_, err := Await[int](stream.Next())
+		if err != context.Canceled {
+			t.Error("canceled error is expected")
+		}
+	})
+
+	t.Run("it should keep order of next", func(t *testing.T) {
+		stream := Buffer[int](context.Background(), FromSlice([]int{10, 11, 12}), 2)
+
+		first, second := stream.Next(), stream.Next()
+		secondResult, _ := Await[int](second)
+		firstResult, _ := Await[int](first)
+		if firstResult != 10 || secondResult != 11 {
+			t.Error("results are not in order of next")
+		}
+	})
+}
+
+func TestMerge(t *testing.T) {
+	t.Run("it should merge elements", func(t *testing.T) {
+		stream := Merge[int](context.Background(), FromSlice([]int{10, 11}), FromSlice([]int{12}))
+
+		result, err := Await[[]int](Collect[int](stream))
+		if err != nil {
+			t.Error("error is not expected")
+		}
+
+		sort.Ints(result)
+		if !reflect.DeepEqual(result, []int{10, 11, 12}) {
+			t.Error("result is not as expected")
+		}
+	})
+
+	t.Run("it should reject error", func(t *testing.T) {
+		expected := errors.New("error")
+
+		stream := Merge[int](context.Background(), FromFunc(func() (int, error) {
+			return 0, expected
+		}))
+
+		_, err := Await[[]int](Collect[int](stream))
+		if err != expected {
+			t.Error("error is not as expected")
+		}
+	})
+
+	t.Run("it should keep order of next", func(t *testing.T) {
+		stream := Merge[int](context.Background(), FromSlice([]int{10, 11, 12}))
+
+		first, second := stream.Next(), stream.Next()
+		secondResult, _ := Await[int](second)
+		firstResult, _ := Await[int](first)
+		if firstResult != 10 || secondResult != 11 {
+			t.Error("results are not in order of next")
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package go_promise
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type UnhandledRejection struct {
+	ID    uint64
+	Name  string
+	Error error
+}
+
+type UnhandledRejectionHandler func(rejection UnhandledRejection)
+
+func TrackUnhandledRejections(handler UnhandledRejectionHandler) func() {
+	return unhandledRejections.track(handler)
+}
+
+var unhandledRejections = &rejectionTracker{
+	mutex: &sync.RWMutex{},
+}
+
+type rejectionTracker struct {
+	mutex    *sync.RWMutex
+	handler  UnhandledRejectionHandler
+	lastID   uint64
+	tracking int32
+}
+
+func (t *rejectionTracker) track(handler UnhandledRejectionHandler) func() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.lastID++
+	id := t.lastID
+	t.handler = handler
+	atomic.StoreInt32(&t.tracking, 1)
+
+	return func() {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+
+		if t.lastID != id {
+			return
+		}
+		t.handler = nil
+		atomic.StoreInt32(&t.tracking, 0)
+	}
+}
+
+func (t *rejectionTracker) isTracking() bool {
+	return atomic.LoadInt32(&t.tracking) == 1
+}
+
+func (t *rejectionTracker) report(meta *metadata, err error) {
+	meta.emit(Event{
+		Kind:  EventUnhandled,
+		Error: err,
+	})
+
+	t.mutex.RLock()
+	handler := t.handler
+	t.mutex.RUnlock()
+
+	if handler != nil {
+		handler(UnhandledRejection{
+			ID:    meta.id,
+			Name:  meta.name,
+			Error: err,
+		})
+	}
+}
+
+type trackable interface {
+	awaitSilently() (any, error)
+	markHandled()
+}
+
+func awaitSilently(promise Awaiter) (any, error) {
+	silent, ok := promise.(trackable)
+	if !ok {
+		return promise.Await()
+	}
+
+	return silent.awaitSilently()
+}
+
+func markHandled(promise Awaiter) {
+	if handled, ok := promise.(trackable); ok {
+		handled.markHandled()
+	}
+}
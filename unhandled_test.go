@@ -0,0 +1,177 @@
+package go_promise
+
+import (
+	"errors"
+	"runtime"
+	"testing"
+	"time"
+)
+
+func waitForRejection(rejections chan UnhandledRejection) (UnhandledRejection, bool) {
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		runtime.GC()
+
+		select {
+		case rejection := <-rejections:
+			return rejection, true
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+
+	return UnhandledRejection{}, false
+}
+
+func TestTrackUnhandledRejections(t *testing.T) {
+	t.Run("it should report dropped rejection", func(t *testing.T) {
+		expected := errors.New("error")
+		rejections := make(chan UnhandledRejection, 10)
+		stop := TrackUnhandledRejections(func(rejection UnhandledRejection) {
+			rejections <- rejection
+		})
+		defer stop()
+
+		release := make(chan bool)
+		_, _ = Await[int](Race[int](Promises{
+			Resolve(10),
+			Function(func() (int, error) {
+				<-release
+				return 0, expected
+			}, Named("loser")),
+		}))
+		close(release)
+
+		rejection, ok := waitForRejection(rejections)
+		if !ok {
+			t.Fatal("rejection is not reported")
+		}
+		if rejection.Name != "loser" || !errors.Is(rejection.Error, expected) {
+			t.Error("rejection is not as expected")
+		}
+	})
+
+	t.Run("it should report rejection which is never awaited", func(t *testing.T) {
+		expected := errors.New("error")
+		rejections := make(chan UnhandledRejection, 10)
+		stop := TrackUnhandledRejections(func(rejection UnhandledRejection) {
+			rejections <- rejection
+		})
+		defer stop()
+
+		debounced := Debounce(func() (int, error) {
+			return 0, expected
+		}, time.Millisecond)
+		debounced()
+
+		rejection, ok := waitForRejection(rejections)
+		if !ok {
+			t.Fatal("rejection is not reported")
+		}
+		if rejection.Error != expected {
+			t.Error("rejection is not as expected")
+		}
+	})
+
+	t.Run("it should not report handled rejections", func(t *testing.T) {
+		rejections := make(chan UnhandledRejection, 10)
+		stop := TrackUnhandledRejections(func(rejection UnhandledRejection) {
+			rejections <- rejection
+		})
+		defer stop()
+
+		_, _ = Await[int](Function(func() (int, error) {
+			return 0, errors.New("error")
+		}))
+		_, _ = Await[int](Function(func() (int, error) {
+			return 0, errors.New("error")
+		}).With(Catch(func(err error) int {
+			return 10
+		})))
+		_, _ = Await[[]int](All[int](Promises{
+			Reject(errors.New("error")),
+		}))
+
+		debounced := Debounce(func() (int, error) {
+			return 0, errors.New("error")
+		}, time.Millisecond)
+		_, _ = Await[int](debounced())
+
+		if _, ok := waitForRejection(rejections); ok {
+			t.Error("rejection is not expected")
+		}
+	})
+
+	t.Run("it should not report rejections drained by all", func(t *testing.T) {
+		rejections := make(chan UnhandledRejection, 10)
+		stop := TrackUnhandledRejections(func(rejection UnhandledRejection) {
+			rejections <- rejection
+		})
+		defer stop()
+
+		release := make(chan bool)
+		_, err := Await[[]int](All[int](Promises{
+			Reject(errors.New("first")),
+			Function(func() (int, error) {
+				<-release
+				return 0, errors.New("second")
+			}),
+		}))
+		if err == nil {
+			t.Error("error is expected")
+		}
+		close(release)
+
+		if _, ok := waitForRejection(rejections); ok {
+			t.Error("rejection is not expected")
+		}
+	})
+
+	t.Run("it should not emit unhandled events without tracking", func(t *testing.T) {
+		recorder := NewRecorder()
+		unregister := RegisterHook(recorder)
+		defer unregister()
+
+		release := make(chan bool)
+		_, _ = Await[int](Race[int](Promises{
+			Resolve(10),
+			Function(func() (int, error) {
+				<-release
+				return 0, errors.New("error")
+			}),
+		}))
+		close(release)
+
+		deadline := time.Now().Add(time.Second)
+		for len(recorder.Filter(EventDrop)) < 1 && time.Now().Before(deadline) {
+			time.Sleep(time.Millisecond)
+		}
+		runtime.GC()
+		time.Sleep(10 * time.Millisecond)
+
+		if len(recorder.Filter(EventUnhandled)) != 0 {
+			t.Error("unhandled event is not expected")
+		}
+	})
+
+	t.Run("it should stop tracking", func(t *testing.T) {
+		rejections := make(chan UnhandledRejection, 10)
+		stop := TrackUnhandledRejections(func(rejection UnhandledRejection) {
+			rejections <- rejection
+		})
+		stop()
+
+		release := make(chan bool)
+		_, _ = Await[int](Race[int](Promises{
+			Resolve(10),
+			Function(func() (int, error) {
+				<-release
+				return 0, errors.New("error")
+			}),
+		}))
+		close(release)
+
+		if _, ok := waitForRejection(rejections); ok {
+			t.Error("rejection is not expected")
+		}
+	})
+}